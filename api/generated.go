@@ -24,14 +24,78 @@ const (
 	UserTokenScopes  = "UserToken.Scopes"
 )
 
+// Defines values for AbsenceKind.
+const (
+	AbsenceKindOTHER     AbsenceKind = "OTHER"
+	AbsenceKindSICKLEAVE AbsenceKind = "SICK_LEAVE"
+	AbsenceKindVACATION  AbsenceKind = "VACATION"
+)
+
+// Defines values for AuditEntryReason.
+const (
+	AuditEntryReasonAbsence      AuditEntryReason = "absence"
+	AuditEntryReasonDeactivation AuditEntryReason = "deactivation"
+	AuditEntryReasonForge        AuditEntryReason = "forge"
+	AuditEntryReasonManual       AuditEntryReason = "manual"
+	AuditEntryReasonRebalance    AuditEntryReason = "rebalance"
+	AuditEntryReasonSla          AuditEntryReason = "sla"
+)
+
+// Defines values for AuditEntryTargetType.
+const (
+	AuditEntryTargetTypeCodeOwnerRule AuditEntryTargetType = "code_owner_rule"
+	AuditEntryTargetTypeForgeIdentity AuditEntryTargetType = "forge_identity"
+	AuditEntryTargetTypePullRequest   AuditEntryTargetType = "pull_request"
+	AuditEntryTargetTypeTeam          AuditEntryTargetType = "team"
+	AuditEntryTargetTypeUser          AuditEntryTargetType = "user"
+	AuditEntryTargetTypeWebhook       AuditEntryTargetType = "webhook"
+)
+
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ALREADYEXISTS   ErrorResponseErrorCode = "ALREADY_EXISTS"
+	CONFLICT        ErrorResponseErrorCode = "CONFLICT"
+	INTERNAL        ErrorResponseErrorCode = "INTERNAL"
+	MERGEBLOCKED    ErrorResponseErrorCode = "MERGE_BLOCKED"
+	NOCANDIDATE     ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTASSIGNED     ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTFOUND        ErrorResponseErrorCode = "NOT_FOUND"
+	PREXISTS        ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED        ErrorResponseErrorCode = "PR_MERGED"
+	TEAMEXISTS      ErrorResponseErrorCode = "TEAM_EXISTS"
+	UNAUTHORIZED    ErrorResponseErrorCode = "UNAUTHORIZED"
+	VALIDATIONERROR ErrorResponseErrorCode = "VALIDATION_ERROR"
+)
+
+// Defines values for ForgeEventResultOutcome.
+const (
+	ForgeEventResultOutcomeCreated ForgeEventResultOutcome = "created"
+	ForgeEventResultOutcomeIgnored ForgeEventResultOutcome = "ignored"
+	ForgeEventResultOutcomeMerged  ForgeEventResultOutcome = "merged"
+)
+
+// Defines values for ForgeName.
+const (
+	Github ForgeName = "github"
+	Gitlab ForgeName = "gitlab"
+)
+
+// Defines values for HealthCheckStatus.
+const (
+	HealthCheckStatusFail HealthCheckStatus = "fail"
+	HealthCheckStatusOk   HealthCheckStatus = "ok"
+)
+
+// Defines values for HealthStatusStatus.
+const (
+	HealthStatusStatusOk          HealthStatusStatus = "ok"
+	HealthStatusStatusUnavailable HealthStatusStatus = "unavailable"
+)
+
+// Defines values for OverduePullRequestStatus.
+const (
+	OverduePullRequestStatusMERGED OverduePullRequestStatus = "MERGED"
+	OverduePullRequestStatusOPEN   OverduePullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestStatus.
@@ -40,38 +104,356 @@ const (
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
+// Defines values for PullRequestEventReason.
+const (
+	PullRequestEventReasonAbsence      PullRequestEventReason = "absence"
+	PullRequestEventReasonDeactivation PullRequestEventReason = "deactivation"
+	PullRequestEventReasonForge        PullRequestEventReason = "forge"
+	PullRequestEventReasonManual       PullRequestEventReason = "manual"
+	PullRequestEventReasonRebalance    PullRequestEventReason = "rebalance"
+	PullRequestEventReasonSla          PullRequestEventReason = "sla"
+)
+
+// Defines values for PullRequestEventReviewState.
+const (
+	PullRequestEventReviewStateAPPROVED         PullRequestEventReviewState = "APPROVED"
+	PullRequestEventReviewStateCHANGESREQUESTED PullRequestEventReviewState = "CHANGES_REQUESTED"
+	PullRequestEventReviewStateCOMMENTED        PullRequestEventReviewState = "COMMENTED"
+)
+
+// Defines values for PullRequestEventType.
+const (
+	PullRequestEventTypeCreated            PullRequestEventType = "created"
+	PullRequestEventTypeMerged             PullRequestEventType = "merged"
+	PullRequestEventTypeReviewSubmitted    PullRequestEventType = "review_submitted"
+	PullRequestEventTypeReviewerAssigned   PullRequestEventType = "reviewer_assigned"
+	PullRequestEventTypeReviewerUnassigned PullRequestEventType = "reviewer_unassigned"
+)
+
 // Defines values for PullRequestShortStatus.
 const (
-	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
-	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
+	MERGED PullRequestShortStatus = "MERGED"
+	OPEN   PullRequestShortStatus = "OPEN"
+)
+
+// Defines values for ReviewState.
+const (
+	ReviewStateAPPROVED         ReviewState = "APPROVED"
+	ReviewStateCHANGESREQUESTED ReviewState = "CHANGES_REQUESTED"
+	ReviewStateCOMMENTED        ReviewState = "COMMENTED"
+)
+
+// Defines values for ReviewerStateState.
+const (
+	ReviewerStateStateAPPROVED         ReviewerStateState = "APPROVED"
+	ReviewerStateStateCHANGESREQUESTED ReviewerStateState = "CHANGES_REQUESTED"
+	ReviewerStateStateCOMMENTED        ReviewerStateState = "COMMENTED"
+	ReviewerStateStatePENDING          ReviewerStateState = "PENDING"
+)
+
+// Defines values for TeamSettingsReviewerStrategy.
+const (
+	LeastLoaded TeamSettingsReviewerStrategy = "least_loaded"
+	Random      TeamSettingsReviewerStrategy = "random"
+	RoundRobin  TeamSettingsReviewerStrategy = "round_robin"
+	Weighted    TeamSettingsReviewerStrategy = "weighted"
+)
+
+// Defines values for WebhookDeliveryStatus.
+const (
+	WebhookDeliveryStatusDELIVERED WebhookDeliveryStatus = "DELIVERED"
+	WebhookDeliveryStatusFAILED    WebhookDeliveryStatus = "FAILED"
+	WebhookDeliveryStatusPENDING   WebhookDeliveryStatus = "PENDING"
+)
+
+// Defines values for WebhookEvent.
+const (
+	PrCreated            WebhookEvent = "pr.created"
+	PrMerged             WebhookEvent = "pr.merged"
+	PrReviewerReassigned WebhookEvent = "pr.reviewer_reassigned"
+	TeamDeactivated      WebhookEvent = "team.deactivated"
+	UserDeactivated      WebhookEvent = "user.deactivated"
+	UserSettingsUpdated  WebhookEvent = "user.settings_updated"
 )
 
+// Defines values for PostPullRequestReviewJSONBodyState.
+const (
+	APPROVED         PostPullRequestReviewJSONBodyState = "APPROVED"
+	CHANGESREQUESTED PostPullRequestReviewJSONBodyState = "CHANGES_REQUESTED"
+	COMMENTED        PostPullRequestReviewJSONBodyState = "COMMENTED"
+)
+
+// Defines values for PostUsersAddAbsenceJSONBodyKind.
+const (
+	PostUsersAddAbsenceJSONBodyKindOTHER     PostUsersAddAbsenceJSONBodyKind = "OTHER"
+	PostUsersAddAbsenceJSONBodyKindSICKLEAVE PostUsersAddAbsenceJSONBodyKind = "SICK_LEAVE"
+	PostUsersAddAbsenceJSONBodyKindVACATION  PostUsersAddAbsenceJSONBodyKind = "VACATION"
+)
+
+// Defines values for GetWebhooksDeliveriesParamsStatus.
+const (
+	DELIVERED GetWebhooksDeliveriesParamsStatus = "DELIVERED"
+	FAILED    GetWebhooksDeliveriesParamsStatus = "FAILED"
+	PENDING   GetWebhooksDeliveriesParamsStatus = "PENDING"
+)
+
+// Absence defines model for Absence.
+type Absence struct {
+	AbsenceId int64 `json:"absence_id"`
+
+	// EndsAt Конец отсутствия (не включается)
+	EndsAt time.Time   `json:"ends_at"`
+	Kind   AbsenceKind `json:"kind"`
+
+	// Processed Открытые ревью пользователя уже переназначены
+	Processed bool      `json:"processed"`
+	StartsAt  time.Time `json:"starts_at"`
+	UserId    string    `json:"user_id"`
+}
+
+// AbsenceKind defines model for Absence.Kind.
+type AbsenceKind string
+
+// AuditEntry defines model for AuditEntry.
+type AuditEntry struct {
+	Action string `json:"action"`
+
+	// Actor Заголовок X-Actor запроса, github или gitlab для их вебхуков, system для фоновых задач
+	Actor string `json:"actor"`
+
+	// After Состояние цели после изменения; отсутствует, если цель удалена
+	After   *map[string]interface{} `json:"after,omitempty"`
+	AuditId int64                   `json:"audit_id"`
+
+	// Before Состояние цели до изменения; отсутствует, если цели не было
+	Before *map[string]interface{} `json:"before,omitempty"`
+
+	// Error Почему действие не выполнено; только у pr.reassign_failed
+	Error         *string              `json:"error,omitempty"`
+	OccurredAt    time.Time            `json:"occurred_at"`
+	PullRequestId *string              `json:"pull_request_id,omitempty"`
+	Reason        AuditEntryReason     `json:"reason"`
+	TargetId      string               `json:"target_id"`
+	TargetType    AuditEntryTargetType `json:"target_type"`
+	TeamName      *string              `json:"team_name,omitempty"`
+
+	// UserIds Затронутые пользователи
+	UserIds []string `json:"user_ids"`
+}
+
+// AuditEntryReason defines model for AuditEntry.Reason.
+type AuditEntryReason string
+
+// AuditEntryTargetType defines model for AuditEntry.TargetType.
+type AuditEntryTargetType string
+
+// CodeOwnerRule defines model for CodeOwnerRule.
+type CodeOwnerRule struct {
+	// Pattern Шаблон пути в стиле CODEOWNERS: без "/" ищется на любой глубине, "/" в начале привязывает к корню,
+	// "**" - любое число каталогов, шаблон каталога распространяется на всё его содержимое
+	Pattern string `json:"pattern"`
+
+	// Teams Команды-владельцы; от каждой назначается один участник
+	Teams *[]string `json:"teams,omitempty"`
+
+	// Users user_id владельцев
+	Users *[]string `json:"users,omitempty"`
+}
+
+// DeactivationReport defines model for DeactivationReport.
+type DeactivationReport struct {
+	DeactivatedUsers []string `json:"deactivated_users"`
+
+	// DryRun true - это план, изменения не сохранены
+	DryRun bool `json:"dry_run"`
+
+	// Reassignments Каждое открытое ревью деактивированных пользователей; пусто при активации
+	Reassignments []Reassignment `json:"reassignments"`
+
+	// Rebalance Перенос ревью между участниками команды; только при активации с rebalance
+	Rebalance *RebalanceReport `json:"rebalance,omitempty"`
+	TeamName  string           `json:"team_name"`
+
+	// UnderReviewed Затронутые открытые PR, где активных ревьюверов меньше min_reviewers команды автора или не осталось ни одного
+	UnderReviewed []UnderReviewedPullRequest `json:"under_reviewed"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
-		Code    ErrorResponseErrorCode `json:"code"`
-		Message string                 `json:"message"`
+		// Code Код ошибки и соответствующий HTTP-статус:
+		// NOT_FOUND - 404; TEAM_EXISTS, VALIDATION_ERROR - 400; UNAUTHORIZED - 401;
+		// PR_EXISTS, ALREADY_EXISTS, PR_MERGED, NOT_ASSIGNED, NO_CANDIDATE, CONFLICT, MERGE_BLOCKED - 409;
+		// INTERNAL - 500.
+		Code ErrorResponseErrorCode `json:"code"`
+
+		// Details Подробности ошибки, например невыполненные условия политики слияния
+		Details *[]string `json:"details,omitempty"`
+		Message string    `json:"message"`
 	} `json:"error"`
 }
 
-// ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
+// ErrorResponseErrorCode Код ошибки и соответствующий HTTP-статус:
+// NOT_FOUND - 404; TEAM_EXISTS, VALIDATION_ERROR - 400; UNAUTHORIZED - 401;
+// PR_EXISTS, ALREADY_EXISTS, PR_MERGED, NOT_ASSIGNED, NO_CANDIDATE, CONFLICT, MERGE_BLOCKED - 409;
+// INTERNAL - 500.
 type ErrorResponseErrorCode string
 
+// ForgeEventResult defines model for ForgeEventResult.
+type ForgeEventResult struct {
+	Outcome ForgeEventResultOutcome `json:"outcome"`
+
+	// PullRequestId github:<owner>/<repo>#<номер> или gitlab:<проект>!<iid>
+	PullRequestId *string `json:"pull_request_id,omitempty"`
+
+	// Reason Почему событие пропущено
+	Reason *string `json:"reason,omitempty"`
+}
+
+// ForgeEventResultOutcome defines model for ForgeEventResult.Outcome.
+type ForgeEventResultOutcome string
+
+// ForgeIdentity defines model for ForgeIdentity.
+type ForgeIdentity struct {
+	// AccountId Числовой id аккаунта на forge. Нужен для GitLab, если MR открывает или переоткрывает не автор - событие содержит только id автора
+	AccountId *int64    `json:"account_id,omitempty"`
+	Forge     ForgeName `json:"forge"`
+	UserId    string    `json:"user_id"`
+
+	// Username Логин на GitHub или username на GitLab
+	Username string `json:"username"`
+}
+
+// ForgeName defines model for ForgeName.
+type ForgeName string
+
+// HealthCheck defines model for HealthCheck.
+type HealthCheck struct {
+	Error *string `json:"error,omitempty"`
+
+	// Name database, migrations или config
+	Name   string            `json:"name"`
+	Status HealthCheckStatus `json:"status"`
+}
+
+// HealthCheckStatus defines model for HealthCheck.Status.
+type HealthCheckStatus string
+
+// HealthStatus defines model for HealthStatus.
+type HealthStatus struct {
+	Checks []HealthCheck      `json:"checks"`
+	Status HealthStatusStatus `json:"status"`
+}
+
+// HealthStatusStatus defines model for HealthStatus.Status.
+type HealthStatusStatus string
+
+// MemberLoad defines model for MemberLoad.
+type MemberLoad struct {
+	// After Открытые ревью после выравнивания
+	After int `json:"after"`
+
+	// Before Открытые ревью до выравнивания
+	Before int    `json:"before"`
+	UserId string `json:"user_id"`
+}
+
+// OverduePullRequest defines model for OverduePullRequest.
+type OverduePullRequest struct {
+	AuthorId         string                   `json:"author_id"`
+	OverdueReviewers []OverdueReviewer        `json:"overdue_reviewers"`
+	PullRequestId    string                   `json:"pull_request_id"`
+	PullRequestName  string                   `json:"pull_request_name"`
+	Status           OverduePullRequestStatus `json:"status"`
+
+	// TeamName Команда автора, чей SLA нарушен
+	TeamName string `json:"team_name"`
+}
+
+// OverduePullRequestStatus defines model for OverduePullRequest.Status.
+type OverduePullRequestStatus string
+
+// OverdueReviewer defines model for OverdueReviewer.
+type OverdueReviewer struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// DueAt Когда истёк срок SLA
+	DueAt time.Time `json:"due_at"`
+
+	// ReassignAt Когда ревьювера заменят автоматически; отсутствует, если автоматическое переназначение выключено
+	ReassignAt *time.Time `json:"reassign_at,omitempty"`
+	UserId     string     `json:"user_id"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (не больше max_reviewers команды автора)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	CreatedAt         *time.Time `json:"createdAt"`
+
+	// ForceMerged PR слит в обход политики слияния команды
+	ForceMerged     *bool      `json:"force_merged,omitempty"`
+	MergedAt        *time.Time `json:"mergedAt"`
+	PullRequestId   string     `json:"pull_request_id"`
+	PullRequestName string     `json:"pull_request_name"`
+
+	// ReviewerTeams Команда каждого назначенного ревьювера (user_id -> team_name); возвращается при создании и переназначении
+	ReviewerTeams *map[string]string `json:"reviewer_teams,omitempty"`
+	Status        PullRequestStatus  `json:"status"`
+
+	// UnderReviewed При создании команда не набрала min_reviewers ревьюверов
+	UnderReviewed *bool `json:"under_reviewed,omitempty"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
 type PullRequestStatus string
 
+// PullRequestDetail defines model for PullRequestDetail.
+type PullRequestDetail struct {
+	Pr PullRequest `json:"pr"`
+
+	// Reviewers Состояние ревью текущих ревьюверов
+	Reviewers []ReviewerState `json:"reviewers"`
+
+	// Reviews Вся история ревью, включая ревью снятых ревьюверов
+	Reviews []Review `json:"reviews"`
+}
+
+// PullRequestEvent defines model for PullRequestEvent.
+type PullRequestEvent struct {
+	Actor string `json:"actor"`
+
+	// ForceMerged Только у merged
+	ForceMerged *bool     `json:"force_merged,omitempty"`
+	OccurredAt  time.Time `json:"occurred_at"`
+
+	// PullRequestName Только у created
+	PullRequestName *string `json:"pull_request_name,omitempty"`
+
+	// Reason Причина назначения или снятия ревьювера; отсутствует у событий, перенесённых из данных до появления истории
+	Reason *PullRequestEventReason `json:"reason,omitempty"`
+
+	// ReviewState Только у review_submitted
+	ReviewState *PullRequestEventReviewState `json:"review_state,omitempty"`
+
+	// Seq Номер события в истории PR, начиная с 1
+	Seq  int                  `json:"seq"`
+	Type PullRequestEventType `json:"type"`
+
+	// UserId Автор для created, ревьювер для остальных событий, кроме merged
+	UserId *string `json:"user_id,omitempty"`
+}
+
+// PullRequestEventReason Причина назначения или снятия ревьювера; отсутствует у событий, перенесённых из данных до появления истории
+type PullRequestEventReason string
+
+// PullRequestEventReviewState Только у review_submitted
+type PullRequestEventReviewState string
+
+// PullRequestEventType defines model for PullRequestEvent.Type.
+type PullRequestEventType string
+
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
 	AuthorId        string                 `json:"author_id"`
@@ -83,6 +465,64 @@ type PullRequestShort struct {
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// Reassignment defines model for Reassignment.
+type Reassignment struct {
+	// NewReviewerId Отсутствует, если замены не нашлось; старый ревьювер тогда остаётся назначенным
+	NewReviewerId *string `json:"new_reviewer_id,omitempty"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+	PullRequestId string  `json:"pull_request_id"`
+
+	// Reason Почему замены не нашлось
+	Reason *string `json:"reason,omitempty"`
+}
+
+// RebalanceReport Перенос ревью между участниками команды; только при активации с rebalance
+type RebalanceReport struct {
+	// DryRun true - это план, изменения не сохранены
+	DryRun bool `json:"dry_run"`
+
+	// Loads Нагрузка активных участников команды
+	Loads    []MemberLoad   `json:"loads"`
+	Moves    []Reassignment `json:"moves"`
+	TeamName string         `json:"team_name"`
+}
+
+// Review defines model for Review.
+type Review struct {
+	Body        string      `json:"body"`
+	ReviewerId  string      `json:"reviewer_id"`
+	State       ReviewState `json:"state"`
+	SubmittedAt time.Time   `json:"submitted_at"`
+}
+
+// ReviewState defines model for Review.State.
+type ReviewState string
+
+// ReviewerLoad defines model for ReviewerLoad.
+type ReviewerLoad struct {
+	// AtCapacity Ограничение достигнуто, новые ревью пользователю не назначаются
+	AtCapacity bool `json:"at_capacity"`
+
+	// MaxOpenReviews Действующее ограничение (личное или команды); 0 — без ограничения
+	MaxOpenReviews int `json:"max_open_reviews"`
+
+	// OpenReviews Число открытых PR, где пользователь назначен ревьювером
+	OpenReviews int    `json:"open_reviews"`
+	TeamName    string `json:"team_name"`
+	UserId      string `json:"user_id"`
+}
+
+// ReviewerState defines model for ReviewerState.
+type ReviewerState struct {
+	// State Последний вердикт ревьювера с момента его назначения; COMMENTED не отменяет APPROVED и CHANGES_REQUESTED
+	State       ReviewerStateState `json:"state"`
+	SubmittedAt *time.Time         `json:"submitted_at"`
+	UserId      string             `json:"user_id"`
+}
+
+// ReviewerStateState Последний вердикт ревьювера с момента его назначения; COMMENTED не отменяет APPROVED и CHANGES_REQUESTED
+type ReviewerStateState string
+
 // Team defines model for Team.
 type Team struct {
 	Members  []TeamMember `json:"members"`
@@ -96,12 +536,116 @@ type TeamMember struct {
 	Username string `json:"username"`
 }
 
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// FallbackTeams Запасные команды в порядке приоритета, из которых добираются ревьюверы, если в команде не хватает кандидатов
+	FallbackTeams *[]string `json:"fallback_teams,omitempty"`
+
+	// MaxOpenReviews Сколько открытых ревью может быть у участника команды одновременно; 0 — без ограничения
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+
+	// MaxReviewers Сколько ревьюверов назначать на PR по умолчанию (2)
+	MaxReviewers *int `json:"max_reviewers,omitempty"`
+
+	// MinApprovals Сколько текущих ревьюверов должны одобрить PR перед слиянием
+	MinApprovals *int `json:"min_approvals,omitempty"`
+
+	// MinReviewers Минимальное число ревьюверов на PR; если кандидатов меньше, PR помечается under_reviewed
+	MinReviewers *int `json:"min_reviewers,omitempty"`
+
+	// ReassignAfterMinutes Через сколько минут после назначения молчащего ревьювера заменяют автоматически (не меньше review_sla_minutes); 0 — не заменять
+	ReassignAfterMinutes *int `json:"reassign_after_minutes,omitempty"`
+
+	// RequireLeadApproval Для слияния нужно одобрение ревьювера, который является лидом команды автора
+	RequireLeadApproval *bool `json:"require_lead_approval,omitempty"`
+
+	// ReviewSlaMinutes За сколько минут ревьювер должен оставить ревью на PR команды; 0 — SLA не отслеживается
+	ReviewSlaMinutes *int `json:"review_sla_minutes,omitempty"`
+
+	// ReviewerStrategy Стратегия выбора ревьюверов; если не задана, используется REVIEWER_STRATEGY
+	ReviewerStrategy *TeamSettingsReviewerStrategy `json:"reviewer_strategy,omitempty"`
+	TeamName         string                        `json:"team_name"`
+}
+
+// TeamSettingsReviewerStrategy Стратегия выбора ревьюверов; если не задана, используется REVIEWER_STRATEGY
+type TeamSettingsReviewerStrategy string
+
+// UnderReviewedPullRequest defines model for UnderReviewedPullRequest.
+type UnderReviewedPullRequest struct {
+	ActiveReviewers []string `json:"active_reviewers"`
+
+	// MinReviewers min_reviewers команды автора
+	MinReviewers  int    `json:"min_reviewers"`
+	PullRequestId string `json:"pull_request_id"`
+}
+
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
+	IsActive bool `json:"is_active"`
+
+	// IsLead Лид команды; учитывается политикой слияния require_lead_approval
+	IsLead *bool `json:"is_lead,omitempty"`
+
+	// MaxOpenReviews Личное ограничение открытых ревью; 0 — действует ограничение команды
+	MaxOpenReviews *int `json:"max_open_reviews,omitempty"`
+
+	// ReviewWeight Вес пользователя для стратегии weighted (0 — не назначать)
+	ReviewWeight *int   `json:"review_weight,omitempty"`
+	TeamName     string `json:"team_name"`
+	UserId       string `json:"user_id"`
+	Username     string `json:"username"`
+}
+
+// Webhook Подписка на события; секрет не возвращается
+type Webhook struct {
+	CreatedAt time.Time      `json:"created_at"`
+	Events    []WebhookEvent `json:"events"`
+	Url       string         `json:"url"`
+	WebhookId int64          `json:"webhook_id"`
+}
+
+// WebhookDelivery defines model for WebhookDelivery.
+type WebhookDelivery struct {
+	Attempts    int          `json:"attempts"`
+	CreatedAt   time.Time    `json:"created_at"`
+	DeliveredAt *time.Time   `json:"delivered_at,omitempty"`
+	DeliveryId  int64        `json:"delivery_id"`
+	Event       WebhookEvent `json:"event"`
+
+	// EventId Одинаков у всех доставок события, по нему получатель отбрасывает повторы
+	EventId       string    `json:"event_id"`
+	LastError     string    `json:"last_error"`
+	NextAttemptAt time.Time `json:"next_attempt_at"`
+
+	// Payload Тело запроса, которое отправляется подписчику
+	Payload map[string]interface{} `json:"payload"`
+
+	// ResponseStatus HTTP-статус последней попытки; 0, если ответа не было
+	ResponseStatus int                   `json:"response_status"`
+	Status         WebhookDeliveryStatus `json:"status"`
+	WebhookId      int64                 `json:"webhook_id"`
+}
+
+// WebhookDeliveryStatus defines model for WebhookDelivery.Status.
+type WebhookDeliveryStatus string
+
+// WebhookEvent defines model for WebhookEvent.
+type WebhookEvent string
+
+// WorkingHours defines model for WorkingHours.
+type WorkingHours struct {
+	// End Конец рабочего дня, HH:MM; если раньше start, смена заканчивается на следующий день
+	End string `json:"end"`
+
+	// Start Начало рабочего дня, HH:MM
+	Start string `json:"start"`
+
+	// Timezone Часовой пояс IANA, например Europe/Moscow
+	Timezone string `json:"timezone"`
 	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+
+	// Weekdays Рабочие дни, 0 - воскресенье
+	Weekdays []int `json:"weekdays"`
 }
 
 // TeamNameQuery defines model for TeamNameQuery.
@@ -110,26 +654,109 @@ type TeamNameQuery = string
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
+// GetAuditParams defines parameters for GetAudit.
+type GetAuditParams struct {
+	PullRequestId *string `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+
+	// UserId Записи, затрагивающие пользователя
+	UserId   *string    `form:"user_id,omitempty" json:"user_id,omitempty"`
+	TeamName *string    `form:"team_name,omitempty" json:"team_name,omitempty"`
+	From     *time.Time `form:"from,omitempty" json:"from,omitempty"`
+	To       *time.Time `form:"to,omitempty" json:"to,omitempty"`
+	Limit    *int       `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// PostCodeOwnersDeleteJSONBody defines parameters for PostCodeOwnersDelete.
+type PostCodeOwnersDeleteJSONBody struct {
+	Pattern string `json:"pattern"`
+}
+
+// PostForgeDeleteIdentityJSONBody defines parameters for PostForgeDeleteIdentity.
+type PostForgeDeleteIdentityJSONBody struct {
+	Forge    ForgeName `json:"forge"`
+	Username string    `json:"username"`
+}
+
+// PostForgeGithubJSONBody defines parameters for PostForgeGithub.
+type PostForgeGithubJSONBody = map[string]interface{}
+
+// PostForgeGithubParams defines parameters for PostForgeGithub.
+type PostForgeGithubParams struct {
+	XGitHubEvent string `json:"X-GitHub-Event"`
+
+	// XHubSignature256 HMAC-SHA256 тела на секрете GITHUB_WEBHOOK_SECRET
+	XHubSignature256 *string `json:"X-Hub-Signature-256,omitempty"`
+}
+
+// PostForgeGitlabJSONBody defines parameters for PostForgeGitlab.
+type PostForgeGitlabJSONBody = map[string]interface{}
+
+// PostForgeGitlabParams defines parameters for PostForgeGitlab.
+type PostForgeGitlabParams struct {
+	XGitlabEvent string `json:"X-Gitlab-Event"`
+
+	// XGitlabToken Должен совпадать с GITLAB_WEBHOOK_TOKEN
+	XGitlabToken *string `json:"X-Gitlab-Token,omitempty"`
+}
+
 // PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
 type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Изменённые файлы; их владельцы из правил code owners назначаются первыми
+	ChangedFiles    *[]string `json:"changed_files,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+
+	// ReviewerCount Число ревьюверов в пределах настроек команды автора
+	ReviewerCount *int `json:"reviewer_count,omitempty"`
+}
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+}
+
+// GetPullRequestHistoryParams defines parameters for GetPullRequestHistory.
+type GetPullRequestHistoryParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
+	Force         *bool  `json:"force,omitempty"`
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// GetPullRequestOverdueParams defines parameters for GetPullRequestOverdue.
+type GetPullRequestOverdueParams struct {
+	// TeamName Только PR авторов из этой команды
+	TeamName *string `form:"team_name,omitempty" json:"team_name,omitempty"`
+}
+
 // PostPullRequestReassignJSONBody defines parameters for PostPullRequestReassign.
 type PostPullRequestReassignJSONBody struct {
 	OldUserId     string `json:"old_user_id"`
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestReviewJSONBody defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBody struct {
+	// Body Обязателен для CHANGES_REQUESTED и COMMENTED
+	Body          *string                            `json:"body,omitempty"`
+	PullRequestId string                             `json:"pull_request_id"`
+	ReviewerId    string                             `json:"reviewer_id"`
+	State         PostPullRequestReviewJSONBodyState `json:"state"`
+}
+
+// PostPullRequestReviewJSONBodyState defines parameters for PostPullRequestReview.
+type PostPullRequestReviewJSONBodyState string
+
 // PostTeamDeactivateJSONBody defines parameters for PostTeamDeactivate.
 type PostTeamDeactivateJSONBody struct {
+	// DryRun Показать план без сохранения
+	DryRun *bool `json:"dry_run,omitempty"`
+
 	// TeamName Уникальное имя команды
 	TeamName string `json:"team_name"`
 }
@@ -140,18 +767,124 @@ type GetTeamGetParams struct {
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// GetTeamGetSettingsParams defines parameters for GetTeamGetSettings.
+type GetTeamGetSettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamRebalanceJSONBody defines parameters for PostTeamRebalance.
+type PostTeamRebalanceJSONBody struct {
+	// DryRun Показать план без сохранения
+	DryRun   *bool  `json:"dry_run,omitempty"`
+	TeamName string `json:"team_name"`
+}
+
+// PostUsersAddAbsenceJSONBody defines parameters for PostUsersAddAbsence.
+type PostUsersAddAbsenceJSONBody struct {
+	EndsAt   time.Time                       `json:"ends_at"`
+	Kind     PostUsersAddAbsenceJSONBodyKind `json:"kind"`
+	StartsAt time.Time                       `json:"starts_at"`
+	UserId   string                          `json:"user_id"`
+}
+
+// PostUsersAddAbsenceJSONBodyKind defines parameters for PostUsersAddAbsence.
+type PostUsersAddAbsenceJSONBodyKind string
+
+// PostUsersDeleteAbsenceJSONBody defines parameters for PostUsersDeleteAbsence.
+type PostUsersDeleteAbsenceJSONBody struct {
+	AbsenceId int64 `json:"absence_id"`
+}
+
+// PostUsersDeleteWorkingHoursJSONBody defines parameters for PostUsersDeleteWorkingHours.
+type PostUsersDeleteWorkingHoursJSONBody struct {
+	UserId string `json:"user_id"`
+}
+
+// GetUsersGetAbsencesParams defines parameters for GetUsersGetAbsences.
+type GetUsersGetAbsencesParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
 }
 
+// GetUsersGetWorkingHoursParams defines parameters for GetUsersGetWorkingHours.
+type GetUsersGetWorkingHoursParams struct {
+	// UserId Идентификатор пользователя
+	UserId UserIdQuery `form:"user_id" json:"user_id"`
+}
+
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
 type PostUsersSetIsActiveJSONBody struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
+	// DryRun Показать план без сохранения
+	DryRun   *bool `json:"dry_run,omitempty"`
+	IsActive bool  `json:"is_active"`
+
+	// Rebalance При активации неактивного пользователя выровнять нагрузку его команды, как /team/rebalance
+	Rebalance *bool  `json:"rebalance,omitempty"`
+	UserId    string `json:"user_id"`
+}
+
+// PostUsersSetSettingsJSONBody defines parameters for PostUsersSetSettings.
+type PostUsersSetSettingsJSONBody struct {
+	IsLead         *bool  `json:"is_lead,omitempty"`
+	MaxOpenReviews *int   `json:"max_open_reviews,omitempty"`
+	ReviewWeight   *int   `json:"review_weight,omitempty"`
+	UserId         string `json:"user_id"`
+}
+
+// PostWebhooksCreateJSONBody defines parameters for PostWebhooksCreate.
+type PostWebhooksCreateJSONBody struct {
+	Events []WebhookEvent `json:"events"`
+
+	// Secret Ключ HMAC-SHA256 для заголовка X-Reviewer-Signature
+	Secret string `json:"secret"`
+	Url    string `json:"url"`
+}
+
+// PostWebhooksDeleteJSONBody defines parameters for PostWebhooksDelete.
+type PostWebhooksDeleteJSONBody struct {
+	WebhookId int64 `json:"webhook_id"`
 }
 
+// GetWebhooksDeliveriesParams defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParams struct {
+	WebhookId *int64                             `form:"webhook_id,omitempty" json:"webhook_id,omitempty"`
+	Status    *GetWebhooksDeliveriesParamsStatus `form:"status,omitempty" json:"status,omitempty"`
+	Limit     *int                               `form:"limit,omitempty" json:"limit,omitempty"`
+}
+
+// GetWebhooksDeliveriesParamsStatus defines parameters for GetWebhooksDeliveries.
+type GetWebhooksDeliveriesParamsStatus string
+
+// PostWebhooksReplayJSONBody defines parameters for PostWebhooksReplay.
+type PostWebhooksReplayJSONBody struct {
+	DeliveryId int64 `json:"delivery_id"`
+}
+
+// PostCodeOwnersDeleteJSONRequestBody defines body for PostCodeOwnersDelete for application/json ContentType.
+type PostCodeOwnersDeleteJSONRequestBody PostCodeOwnersDeleteJSONBody
+
+// PostCodeOwnersSetJSONRequestBody defines body for PostCodeOwnersSet for application/json ContentType.
+type PostCodeOwnersSetJSONRequestBody = CodeOwnerRule
+
+// PostForgeDeleteIdentityJSONRequestBody defines body for PostForgeDeleteIdentity for application/json ContentType.
+type PostForgeDeleteIdentityJSONRequestBody PostForgeDeleteIdentityJSONBody
+
+// PostForgeGithubJSONRequestBody defines body for PostForgeGithub for application/json ContentType.
+type PostForgeGithubJSONRequestBody = PostForgeGithubJSONBody
+
+// PostForgeGitlabJSONRequestBody defines body for PostForgeGitlab for application/json ContentType.
+type PostForgeGitlabJSONRequestBody = PostForgeGitlabJSONBody
+
+// PostForgeSetIdentityJSONRequestBody defines body for PostForgeSetIdentity for application/json ContentType.
+type PostForgeSetIdentityJSONRequestBody = ForgeIdentity
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
 type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
 
@@ -161,29 +894,110 @@ type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestReviewJSONRequestBody defines body for PostPullRequestReview for application/json ContentType.
+type PostPullRequestReviewJSONRequestBody PostPullRequestReviewJSONBody
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
 // PostTeamDeactivateJSONRequestBody defines body for PostTeamDeactivate for application/json ContentType.
 type PostTeamDeactivateJSONRequestBody PostTeamDeactivateJSONBody
 
+// PostTeamRebalanceJSONRequestBody defines body for PostTeamRebalance for application/json ContentType.
+type PostTeamRebalanceJSONRequestBody PostTeamRebalanceJSONBody
+
+// PostTeamSetSettingsJSONRequestBody defines body for PostTeamSetSettings for application/json ContentType.
+type PostTeamSetSettingsJSONRequestBody = TeamSettings
+
+// PostUsersAddAbsenceJSONRequestBody defines body for PostUsersAddAbsence for application/json ContentType.
+type PostUsersAddAbsenceJSONRequestBody PostUsersAddAbsenceJSONBody
+
+// PostUsersDeleteAbsenceJSONRequestBody defines body for PostUsersDeleteAbsence for application/json ContentType.
+type PostUsersDeleteAbsenceJSONRequestBody PostUsersDeleteAbsenceJSONBody
+
+// PostUsersDeleteWorkingHoursJSONRequestBody defines body for PostUsersDeleteWorkingHours for application/json ContentType.
+type PostUsersDeleteWorkingHoursJSONRequestBody PostUsersDeleteWorkingHoursJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetSettingsJSONRequestBody defines body for PostUsersSetSettings for application/json ContentType.
+type PostUsersSetSettingsJSONRequestBody PostUsersSetSettingsJSONBody
+
+// PostUsersSetWorkingHoursJSONRequestBody defines body for PostUsersSetWorkingHours for application/json ContentType.
+type PostUsersSetWorkingHoursJSONRequestBody = WorkingHours
+
+// PostWebhooksCreateJSONRequestBody defines body for PostWebhooksCreate for application/json ContentType.
+type PostWebhooksCreateJSONRequestBody PostWebhooksCreateJSONBody
+
+// PostWebhooksDeleteJSONRequestBody defines body for PostWebhooksDelete for application/json ContentType.
+type PostWebhooksDeleteJSONRequestBody PostWebhooksDeleteJSONBody
+
+// PostWebhooksReplayJSONRequestBody defines body for PostWebhooksReplay for application/json ContentType.
+type PostWebhooksReplayJSONRequestBody PostWebhooksReplayJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Получить журнал аудита изменений, начиная с последних
+	// (GET /audit)
+	GetAudit(ctx echo.Context, params GetAuditParams) error
+	// Удалить правило
+	// (POST /codeOwners/delete)
+	PostCodeOwnersDelete(ctx echo.Context) error
+	// Получить правила code owners (для каждого файла действует последнее подходящее правило)
+	// (GET /codeOwners/list)
+	GetCodeOwnersList(ctx echo.Context) error
+	// Добавить правило или заменить владельцев правила с тем же шаблоном
+	// (POST /codeOwners/set)
+	PostCodeOwnersSet(ctx echo.Context) error
+	// Удалить сопоставление
+	// (POST /forge/deleteIdentity)
+	PostForgeDeleteIdentity(ctx echo.Context) error
+	// Принять событие pull_request из вебхука GitHub
+	// (POST /forge/github)
+	PostForgeGithub(ctx echo.Context, params PostForgeGithubParams) error
+	// Принять событие Merge Request Hook из вебхука GitLab
+	// (POST /forge/gitlab)
+	PostForgeGitlab(ctx echo.Context, params PostForgeGitlabParams) error
+	// Получить сопоставление пользователей GitHub и GitLab с user_id
+	// (GET /forge/identities)
+	GetForgeIdentities(ctx echo.Context) error
+	// Сопоставить пользователя GitHub или GitLab с user_id (существующее сопоставление заменяется)
+	// (POST /forge/setIdentity)
+	PostForgeSetIdentity(ctx echo.Context) error
+	// Проверка, что процесс запущен и отвечает
+	// (GET /health/live)
+	GetHealthLive(ctx echo.Context) error
+	// Проверка готовности принимать трафик (БД, миграции, конфигурация)
+	// (GET /health/ready)
+	GetHealthReady(ctx echo.Context) error
+	// Создать PR и автоматически назначить ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(ctx echo.Context) error
+	// Получить PR с состоянием ревьюверов и историей ревью
+	// (GET /pullRequest/get)
+	GetPullRequestGet(ctx echo.Context, params GetPullRequestGetParams) error
+	// Получить историю PR
+	// (GET /pullRequest/history)
+	GetPullRequestHistory(ctx echo.Context, params GetPullRequestHistoryParams) error
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(ctx echo.Context) error
+	// Получить открытые PR, ревьюверы которых не ответили в срок SLA команды автора
+	// (GET /pullRequest/overdue)
+	GetPullRequestOverdue(ctx echo.Context, params GetPullRequestOverdueParams) error
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(ctx echo.Context) error
+	// Оставить ревью на PR от имени назначенного ревьювера
+	// (POST /pullRequest/review)
+	PostPullRequestReview(ctx echo.Context) error
 	// Получить статистику назначений ревьюверов по пользователям
 	// (GET /stats/assignments)
 	GetStatsAssignments(ctx echo.Context) error
+	// Получить текущую нагрузку активных ревьюверов с учётом ограничений
+	// (GET /stats/load)
+	GetStatsLoad(ctx echo.Context) error
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(ctx echo.Context) error
@@ -193,12 +1007,57 @@ type ServerInterface interface {
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(ctx echo.Context, params GetTeamGetParams) error
+	// Получить настройки команды
+	// (GET /team/getSettings)
+	GetTeamGetSettings(ctx echo.Context, params GetTeamGetSettingsParams) error
+	// Выровнять нагрузку ревью внутри команды
+	// (POST /team/rebalance)
+	PostTeamRebalance(ctx echo.Context) error
+	// Изменить настройки команды (заданные поля перезаписываются)
+	// (POST /team/setSettings)
+	PostTeamSetSettings(ctx echo.Context) error
+	// Добавить период отсутствия (отпуск, больничный)
+	// (POST /users/addAbsence)
+	PostUsersAddAbsence(ctx echo.Context) error
+	// Удалить период отсутствия
+	// (POST /users/deleteAbsence)
+	PostUsersDeleteAbsence(ctx echo.Context) error
+	// Удалить рабочие часы; пользователь снова доступен в любое время
+	// (POST /users/deleteWorkingHours)
+	PostUsersDeleteWorkingHours(ctx echo.Context) error
+	// Получить периоды отсутствия пользователя
+	// (GET /users/getAbsences)
+	GetUsersGetAbsences(ctx echo.Context, params GetUsersGetAbsencesParams) error
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(ctx echo.Context, params GetUsersGetReviewParams) error
+	// Получить рабочие часы пользователя
+	// (GET /users/getWorkingHours)
+	GetUsersGetWorkingHours(ctx echo.Context, params GetUsersGetWorkingHoursParams) error
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(ctx echo.Context) error
+	// Изменить настройки пользователя (заданные поля перезаписываются)
+	// (POST /users/setSettings)
+	PostUsersSetSettings(ctx echo.Context) error
+	// Задать рабочие часы пользователя; вне них он не выбирается ревьювером
+	// (POST /users/setWorkingHours)
+	PostUsersSetWorkingHours(ctx echo.Context) error
+	// Подписать URL на события
+	// (POST /webhooks/create)
+	PostWebhooksCreate(ctx echo.Context) error
+	// Удалить подписку вместе с журналом её доставок
+	// (POST /webhooks/delete)
+	PostWebhooksDelete(ctx echo.Context) error
+	// Получить журнал доставок, начиная с последних
+	// (GET /webhooks/deliveries)
+	GetWebhooksDeliveries(ctx echo.Context, params GetWebhooksDeliveriesParams) error
+	// Получить подписки
+	// (GET /webhooks/list)
+	GetWebhooksList(ctx echo.Context) error
+	// Повторно отправить доставку (счётчик попыток обнуляется, event_id сохраняется)
+	// (POST /webhooks/replay)
+	PostWebhooksReplay(ctx echo.Context) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -206,8 +1065,239 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
-// PostPullRequestCreate converts echo context to params.
-func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
+// GetAudit converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAudit(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuditParams
+	// ------------- Optional query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// ------------- Optional query parameter "from" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "from", ctx.QueryParams(), &params.From)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter from: %s", err))
+	}
+
+	// ------------- Optional query parameter "to" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "to", ctx.QueryParams(), &params.To)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter to: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAudit(ctx, params)
+	return err
+}
+
+// PostCodeOwnersDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCodeOwnersDelete(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCodeOwnersDelete(ctx)
+	return err
+}
+
+// GetCodeOwnersList converts echo context to params.
+func (w *ServerInterfaceWrapper) GetCodeOwnersList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetCodeOwnersList(ctx)
+	return err
+}
+
+// PostCodeOwnersSet converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCodeOwnersSet(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCodeOwnersSet(ctx)
+	return err
+}
+
+// PostForgeDeleteIdentity converts echo context to params.
+func (w *ServerInterfaceWrapper) PostForgeDeleteIdentity(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostForgeDeleteIdentity(ctx)
+	return err
+}
+
+// PostForgeGithub converts echo context to params.
+func (w *ServerInterfaceWrapper) PostForgeGithub(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostForgeGithubParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-GitHub-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-GitHub-Event")]; found {
+		var XGitHubEvent string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-GitHub-Event, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-GitHub-Event", valueList[0], &XGitHubEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-GitHub-Event: %s", err))
+		}
+
+		params.XGitHubEvent = XGitHubEvent
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-GitHub-Event is required, but not found"))
+	}
+	// ------------- Optional header parameter "X-Hub-Signature-256" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Hub-Signature-256")]; found {
+		var XHubSignature256 string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Hub-Signature-256, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Hub-Signature-256", valueList[0], &XHubSignature256, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Hub-Signature-256: %s", err))
+		}
+
+		params.XHubSignature256 = &XHubSignature256
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostForgeGithub(ctx, params)
+	return err
+}
+
+// PostForgeGitlab converts echo context to params.
+func (w *ServerInterfaceWrapper) PostForgeGitlab(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostForgeGitlabParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Gitlab-Event" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Event")]; found {
+		var XGitlabEvent string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Gitlab-Event, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Event", valueList[0], &XGitlabEvent, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: true})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Gitlab-Event: %s", err))
+		}
+
+		params.XGitlabEvent = XGitlabEvent
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Gitlab-Event is required, but not found"))
+	}
+	// ------------- Optional header parameter "X-Gitlab-Token" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Gitlab-Token")]; found {
+		var XGitlabToken string
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Gitlab-Token, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "X-Gitlab-Token", valueList[0], &XGitlabToken, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Gitlab-Token: %s", err))
+		}
+
+		params.XGitlabToken = &XGitlabToken
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostForgeGitlab(ctx, params)
+	return err
+}
+
+// GetForgeIdentities converts echo context to params.
+func (w *ServerInterfaceWrapper) GetForgeIdentities(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetForgeIdentities(ctx)
+	return err
+}
+
+// PostForgeSetIdentity converts echo context to params.
+func (w *ServerInterfaceWrapper) PostForgeSetIdentity(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostForgeSetIdentity(ctx)
+	return err
+}
+
+// GetHealthLive converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHealthLive(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealthLive(ctx)
+	return err
+}
+
+// GetHealthReady converts echo context to params.
+func (w *ServerInterfaceWrapper) GetHealthReady(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetHealthReady(ctx)
+	return err
+}
+
+// PostPullRequestCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	var err error
 
 	ctx.Set(AdminTokenScopes, []string{})
@@ -217,6 +1307,50 @@ func (w *ServerInterfaceWrapper) PostPullRequestCreate(ctx echo.Context) error {
 	return err
 }
 
+// GetPullRequestGet converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestGet(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	ctx.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestGet(ctx, params)
+	return err
+}
+
+// GetPullRequestHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	ctx.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestHistoryParams
+	// ------------- Required query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", ctx.QueryParams(), &params.PullRequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter pull_request_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestHistory(ctx, params)
+	return err
+}
+
 // PostPullRequestMerge converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
 	var err error
@@ -228,6 +1362,26 @@ func (w *ServerInterfaceWrapper) PostPullRequestMerge(ctx echo.Context) error {
 	return err
 }
 
+// GetPullRequestOverdue converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPullRequestOverdue(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestOverdueParams
+	// ------------- Optional query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPullRequestOverdue(ctx, params)
+	return err
+}
+
 // PostPullRequestReassign converts echo context to params.
 func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error {
 	var err error
@@ -239,6 +1393,19 @@ func (w *ServerInterfaceWrapper) PostPullRequestReassign(ctx echo.Context) error
 	return err
 }
 
+// PostPullRequestReview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPullRequestReview(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	ctx.Set(UserTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPullRequestReview(ctx)
+	return err
+}
+
 // GetStatsAssignments converts echo context to params.
 func (w *ServerInterfaceWrapper) GetStatsAssignments(ctx echo.Context) error {
 	var err error
@@ -248,6 +1415,15 @@ func (w *ServerInterfaceWrapper) GetStatsAssignments(ctx echo.Context) error {
 	return err
 }
 
+// GetStatsLoad converts echo context to params.
+func (w *ServerInterfaceWrapper) GetStatsLoad(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetStatsLoad(ctx)
+	return err
+}
+
 // PostTeamAdd converts echo context to params.
 func (w *ServerInterfaceWrapper) PostTeamAdd(ctx echo.Context) error {
 	var err error
@@ -290,6 +1466,101 @@ func (w *ServerInterfaceWrapper) GetTeamGet(ctx echo.Context) error {
 	return err
 }
 
+// GetTeamGetSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) GetTeamGetSettings(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetSettingsParams
+	// ------------- Required query parameter "team_name" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", ctx.QueryParams(), &params.TeamName)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter team_name: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetTeamGetSettings(ctx, params)
+	return err
+}
+
+// PostTeamRebalance converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamRebalance(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamRebalance(ctx)
+	return err
+}
+
+// PostTeamSetSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) PostTeamSetSettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostTeamSetSettings(ctx)
+	return err
+}
+
+// PostUsersAddAbsence converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersAddAbsence(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersAddAbsence(ctx)
+	return err
+}
+
+// PostUsersDeleteAbsence converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersDeleteAbsence(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersDeleteAbsence(ctx)
+	return err
+}
+
+// PostUsersDeleteWorkingHours converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersDeleteWorkingHours(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersDeleteWorkingHours(ctx)
+	return err
+}
+
+// GetUsersGetAbsences converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersGetAbsences(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	ctx.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetAbsencesParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersGetAbsences(ctx, params)
+	return err
+}
+
 // GetUsersGetReview converts echo context to params.
 func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	var err error
@@ -312,6 +1583,28 @@ func (w *ServerInterfaceWrapper) GetUsersGetReview(ctx echo.Context) error {
 	return err
 }
 
+// GetUsersGetWorkingHours converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUsersGetWorkingHours(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	ctx.Set(UserTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetWorkingHoursParams
+	// ------------- Required query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUsersGetWorkingHours(ctx, params)
+	return err
+}
+
 // PostUsersSetIsActive converts echo context to params.
 func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
 	var err error
@@ -323,6 +1616,106 @@ func (w *ServerInterfaceWrapper) PostUsersSetIsActive(ctx echo.Context) error {
 	return err
 }
 
+// PostUsersSetSettings converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetSettings(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetSettings(ctx)
+	return err
+}
+
+// PostUsersSetWorkingHours converts echo context to params.
+func (w *ServerInterfaceWrapper) PostUsersSetWorkingHours(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostUsersSetWorkingHours(ctx)
+	return err
+}
+
+// PostWebhooksCreate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksCreate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksCreate(ctx)
+	return err
+}
+
+// PostWebhooksDelete converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksDelete(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksDelete(ctx)
+	return err
+}
+
+// GetWebhooksDeliveries converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooksDeliveries(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetWebhooksDeliveriesParams
+	// ------------- Optional query parameter "webhook_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "webhook_id", ctx.QueryParams(), &params.WebhookId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter webhook_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "status" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "status", ctx.QueryParams(), &params.Status)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter status: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhooksDeliveries(ctx, params)
+	return err
+}
+
+// GetWebhooksList converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWebhooksList(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWebhooksList(ctx)
+	return err
+}
+
+// PostWebhooksReplay converts echo context to params.
+func (w *ServerInterfaceWrapper) PostWebhooksReplay(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminTokenScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostWebhooksReplay(ctx)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -351,62 +1744,241 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.GET(baseURL+"/audit", wrapper.GetAudit)
+	router.POST(baseURL+"/codeOwners/delete", wrapper.PostCodeOwnersDelete)
+	router.GET(baseURL+"/codeOwners/list", wrapper.GetCodeOwnersList)
+	router.POST(baseURL+"/codeOwners/set", wrapper.PostCodeOwnersSet)
+	router.POST(baseURL+"/forge/deleteIdentity", wrapper.PostForgeDeleteIdentity)
+	router.POST(baseURL+"/forge/github", wrapper.PostForgeGithub)
+	router.POST(baseURL+"/forge/gitlab", wrapper.PostForgeGitlab)
+	router.GET(baseURL+"/forge/identities", wrapper.GetForgeIdentities)
+	router.POST(baseURL+"/forge/setIdentity", wrapper.PostForgeSetIdentity)
+	router.GET(baseURL+"/health/live", wrapper.GetHealthLive)
+	router.GET(baseURL+"/health/ready", wrapper.GetHealthReady)
 	router.POST(baseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.GET(baseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	router.GET(baseURL+"/pullRequest/history", wrapper.GetPullRequestHistory)
 	router.POST(baseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.GET(baseURL+"/pullRequest/overdue", wrapper.GetPullRequestOverdue)
 	router.POST(baseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(baseURL+"/pullRequest/review", wrapper.PostPullRequestReview)
 	router.GET(baseURL+"/stats/assignments", wrapper.GetStatsAssignments)
+	router.GET(baseURL+"/stats/load", wrapper.GetStatsLoad)
 	router.POST(baseURL+"/team/add", wrapper.PostTeamAdd)
 	router.POST(baseURL+"/team/deactivate", wrapper.PostTeamDeactivate)
 	router.GET(baseURL+"/team/get", wrapper.GetTeamGet)
+	router.GET(baseURL+"/team/getSettings", wrapper.GetTeamGetSettings)
+	router.POST(baseURL+"/team/rebalance", wrapper.PostTeamRebalance)
+	router.POST(baseURL+"/team/setSettings", wrapper.PostTeamSetSettings)
+	router.POST(baseURL+"/users/addAbsence", wrapper.PostUsersAddAbsence)
+	router.POST(baseURL+"/users/deleteAbsence", wrapper.PostUsersDeleteAbsence)
+	router.POST(baseURL+"/users/deleteWorkingHours", wrapper.PostUsersDeleteWorkingHours)
+	router.GET(baseURL+"/users/getAbsences", wrapper.GetUsersGetAbsences)
 	router.GET(baseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.GET(baseURL+"/users/getWorkingHours", wrapper.GetUsersGetWorkingHours)
 	router.POST(baseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(baseURL+"/users/setSettings", wrapper.PostUsersSetSettings)
+	router.POST(baseURL+"/users/setWorkingHours", wrapper.PostUsersSetWorkingHours)
+	router.POST(baseURL+"/webhooks/create", wrapper.PostWebhooksCreate)
+	router.POST(baseURL+"/webhooks/delete", wrapper.PostWebhooksDelete)
+	router.GET(baseURL+"/webhooks/deliveries", wrapper.GetWebhooksDeliveries)
+	router.GET(baseURL+"/webhooks/list", wrapper.GetWebhooksList)
+	router.POST(baseURL+"/webhooks/replay", wrapper.PostWebhooksReplay)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/9xa3U4jyRV+lVIl0s5KHmzMECm+886whIthHOOVoiCECncBvWN3e/qHLEKWwGyymzAK",
-	"WSkX0Uq7o9G+gMeDgweweYVTbxSdqnb/2N1tewxMtDfIdFdXnTr1ne98daqOaNWsN0yDG45NC0e0wSxW",
-	"5w635H8VzurrrM7/6HLrEB9o3K5aesPRTYMWKPwCfejBFbThWryGPgygS6AHN+KcwBUM4Aba0IcLcUYz",
-	"VMcvXsmOMtRgdU4L1OGsvi1/Z6jFX7m6xTVacCyXZ6hd3ed1hoM6hw1sbDuWbuzRZjNDv7K5taYlWfUf",
-	"uIAu9EULeuJbZZ9owUAcE7iFgTT1EgbQkY+7cC3OE8xzbW5t69pMxjWHL6UDVyzLtMrcbpiGzfEB/4bV",
-	"GzX1E9/hj6qpYRfrLyrbX774av0ZzdA6t222h08tbpuuVeXEMB2ya7qGJj3QsMwGtxyd25Guoo9Vx0eU",
-	"G26dFjZpZaX4fHvlT2sblQ2aoaVy5PfzlfLqCo6NdhQ3NtZW171/t58W15+tPStWVry3ysqtzOjkQ3bH",
-	"rVrgxE1lWtA+6Mvc+ZpXnbH2aobjzTK05NZqZf7K5bYz7gFm2/qewbVtix/o/C8erKN48VaZQB/acIl/",
-	"xXeIH+iLM/FXIo6hCx3xWvwTOtAVx4gc8ii3sJD/HGHj8LodM13fUGZZ7BD/Z66zb0o4xbWuWpw5XCvK",
-	"OeyaVp05tEA15vDHji7jw3BrNbaD0FEQjPG9tTdfDw23Vtu2lC+TDI20UXES08p2mOPaYey9KK2s0wz1",
-	"UDaOnZH1HjUlbuCwT/0hM3FrPgE3G/umFQee1BX7NTgrzi/I+eO+qPP6jhc9PuR/a/FdWqC/yQYpJOtx",
-	"XxZ7eS6/iYuFgPcnEkU4RQyNSDLbG3DMeN3eZlVHPwgPt2OaNc4M/HRI83Frg++mMzRIFv43mdDIcTZj",
-	"GpvZ2jTf3etcwiuRNi/sTDd2TTmM7iDf0FKZlL1oJEUZn3VuOGSDWwd6lZNHFW47pMLslxnyJavVSD6X",
-	"X0Z6PeCWrVh6cSG3kMNZmA1usIZOC3RpIbewhGBnzr70XLYRxHRWMap0r6lSAzqZIemvaWiSaTshDniq",
-	"mis/cNv5wtQOVQ41HG7I71mjUdOrsofs1zZaFcnnIbqg7iKNYQjasB4v5nKLsQFaoEVNIzZnVnWfNsMS",
-	"41Ow0pwME4+KqIqSD5QykhPL5xZnc3jDSkrxm9TNI3iX0JC7XJeArBVHN1MWSpmXRpJh6dJsxrosKlVK",
-	"ZSJOYACXcIHKGhfzSe7JFF4LbEyzJ6pWY8aHf0FHSelsWN9DG8VTVymoD576PlPW/X62NR0VxWGRGoji",
-	"UpnoGmE1izPtkPBvdNuxR9Zirnmin0/hv9Al4kScir9DV5yIFnTEKXRFS43Eq66lO4e0sHlEi1pdNyrm",
-	"S27QwuZWcytDbbdeZ7g7ofB2uGCiJV6TUplAj0BbORI9KPcp3+EQcIWvwjK0J7+BCxiQfLwShR5cjmy2",
-	"/N7FMbSRuNmejIkQ3Gy6hZOIEKbUj1Pz5XPZeg66TI7CtJiaSG8TiOvjiCn3MMQUKHiKCfDxYu5x/kll",
-	"MV9YelJY/t2f74y6PF358OQFHclfMpgG4lxWD3pkaM4DkxnaM8paM4b2Gxl2XRnBw+C+gjZceXMij6An",
-	"O76BWxjIakNftGRsnxMYwK0M47b4G/TE+efTh6rFFbimjtby8IM5AtasBVD2QJtPhWQKvLCvNKk6d5xn",
-	"IkN8+qhHneou37scwTk0aqzKte0dRKi7TO8uyEc6T6mjDKADA3gPg/GcJRNS+lJaNDrS1hTkAm9k792x",
-	"Ik4PI7wjzlTpDwMe7fskZNODa8zv8TXI13FkNKOA8nbqmESk6QFR/aTGgEvknRtJQ+dKWiAvncA1dIlf",
-	"ADxgNTdJjPmNAjFWZYZhOmTIScQ0iLKBlMrKFYb5lBmarnn7sahdoiX1DeYEcQq3XtVN0ihKy55STuir",
-	"NNNGqpSBdYZJ1E6VeJCSG8/q0B6iGwQ3tkNDnaIXvyOGvkldtHfiDK7HCohxgu0mfRKRymu4COztnXVb",
-	"1oGHJEMckzj7uu15+u4EMPwEbXEsTsX3QRBdqGTnF0bhFsMZOohr4qWymPgT5zMn1fGePB2MMrcPV/ha",
-	"ptEkjpFLQeACp4BNZDOllLvq9+jpREriRYq1s8yvWsgo2+MxSXeVOxvYuBhqO19W2cSmLjZcDtV2MG80",
-	"M/6rpcirPJX+9HHgl+tGzwZcNf7Iwv8oadLbkOCeB701TqofAhrXDYfvqTpfckpPLDApO+KqY9GiYQxG",
-	"34qWt3tCQ+U5T7ypEn+jsXzqw0p+HulInMZ2lLADu0UXJZwsyYAfgusPnNWcfQ9WyDpZpmnpGq7CWb2o",
-	"afPoNr90uxmpLaozgAimwiVCWqzpVS5hlvZRPvrRF+aOhF+oSEkb7FCFwtT0VPEJ+Y4rRo5X2/7ULtlh",
-	"1ZfcO8lLEmdDW6dw1DT66MdIuSZcRYK2Ehq5+So10cPFIHf5877Hes3o7CbVbpKKM5G0cErECZE00ZY9",
-	"DI+6b6BHHgUOFD+IVhYG8M7TvRj0UtTEMgJ04UN4o4craIcZQeMSWBNL1/jhs6DtHPwwOVbHgemXjee4",
-	"EDDtuc/9bd6SZhg6kBu6iZovY02eNfCksm/DlcwzHeipHHKHYRh/iwC9GdwgIKZFDJMgSdkoIUOYu7+g",
-	"HNve4DNvSzQQ30MP3iFwZhSL/453p4rmjjiBrlSqSaE4VjntxStZT3/2sLOBaKEEFWeiJc6gi9o7JZ49",
-	"oZikF7H9KnfkKVZw82Yz3vVBk2z0Zg46Zb7ixf9NRpxdI4yg7md4J/4BXcTEKOc8+HHJxAiYiPbMkTwl",
-	"TitAhqXstAksAbGSERCy6rA2Dbholb3qt5wVv+EbXPOjN1wmU8Pfa5Vta3TDNd2BxfS3J8bupsTcofiI",
-	"vVbUmKnKam/hFrdFMIArUip/5m94Ym/R3TGYS+XPxFmGwHuMltS62VRllyHgJXIjgLe5s2YX/TsXyaJL",
-	"froRaj2H7AoR5i6r2Xx6TH30dZZEYEy6znHHlXLXu/cy7oK4lDAxlaS4ajhSWrDJWzjTabmfQyr/B1UI",
-	"Qw2RgEyVbxYfMN/IcmpWFrkV8j0D23CBrA99dcYMH4isqF4FVeaHzIpvpi+Az6QFf1GFHG+FvNrOt3AN",
-	"bXhPQiqx751A9tLuA4+xRdN/djS8H6xSJ9Ka90A1Dj2IlBNDz71KUHOr+b8AAAD//+J6bhmBLQAA",
+	"H4sIAAAAAAAC/+x963IbR5bmq+TUbMRIvUUSpCR3NPULLcESoymSDVJ2d4sKRBFIkjUCq9hVBckchSJE",
+	"0vJlpLHWDk9Mx8za3d7ejdmfIEVYEEWCr5D1CvskG3kysyqzKusCELrY0z/sEIFC5e2ck+f6nYdG093a",
+	"dh3sBL4x+9DYtjxrCwfYg79WsLW1YG3h33awt0M/aGG/6dnbge06xqxB/kpOSZ8cky55HT4jp2RAeoj0",
+	"yUn4HJFjMiAnpEtOyVH41DANm/7ij/Ai03CsLWzMGgG2thrwb9Pw8B87todbxmzgdbBp+M1NvGXRQYOd",
+	"bfqwH3i2s2E8emQat33szbWyZvUnckR65DTcI/3wUza/cI8MwseInJEBTPUlGZBD+LhHXofPM6bX8bHX",
+	"sFtDTe6R+BI2sLrmY6eJYWc9dxt7gY3hC4t9Qd8++9BYd70tKzBmDdsJPrhsmOKtthPgDewZj0wDOy2/",
+	"YQWa5f47GZBT0gs/Q2QQ7oW74T78f48ckn74HF2gXyJySI7J6/Cr8HPSJT144PlFw4xHblkBngjsLRyP",
+	"LtZkGvdsB+aJnc6WMXvH+Kh6rboyt7hgmMby3LXfNOZr1Y9qhmksrtys1Y27mjdse24T+z5uaRbwfbhH",
+	"jsPH4dNwL3xKeih8THrkMHwWfpV5YCjcJz/SZZ2RHjx+SrrkJf1/+Dn9CyiOT2LNddvYcugs/MDyArGN",
+	"5ZYuaEBLhzFZ3JFP1JQoB7ZOHjk+SnlX4j1z1/4RNwM6dLXTsoOaE3g7Gvppst17aOBPrK3tNv3ltjfp",
+	"4fs2foC9hoct37c3HNzSLcpqBq6nOYl/I13ygu447PaAHKPfTVTps4i8JF1yFj4mg3CXdE20YQebnTXK",
+	"7K9Jn/7VttYQOYKzIf3wCSW4HjkIn4T7IAkOTeTv+AHeEg+FnwLZDshh+DR8wt5/RI9PO9/1AOvm+wOd",
+	"DvD2c5BEPRR+RgmE9IFywl3ymkmkl+QEiIT+1w+fX02xSrhPucJEpAc/6vMXhc8opR1RAceIzNCck0XP",
+	"qTwnr+F118NDruaIDM6zDvoKEAMH4VN6urplYM/TEsWfyQCY6iTcRyBZXwnpQnr8rYfhU8apfGqDq4iu",
+	"Azj3mAxQuI+AOBlNNtYtu60nTLfZ7Hgebg3FotuddrtBWRH7gZ5VKadavuvIMmzLcjpW2zCNFqbMdN+C",
+	"9ZqCjynPti0mITfY/bRmtS36jU6+BZa3gTNH59+yz+Mp0OuPCwtDXYZhGk23hRvuA4cyc6dNZ/AAr226",
+	"7j0xp4bdwk5gBzv6CUVXq25CXD75ehkQ7lFGJ6fhPpfIejFM+oZp2AHe8vWLZh9YnmftpKWl4Bn1zIVo",
+	"MoWAU/dO3mdpEdHx6qToNbeFF+k21ukupgTpthUE2HM0G/F/SZccgCg8ReSM7gXlokME1N8HyXJt8Xpt",
+	"8eOFWn15FpED0iMv0aoxtWpQEfiluGYpj3QRvXzJARmQV4i8IK/DfXJA+pRdTPGTQ8TvLxA2CKRtnxyG",
+	"z8nL8CnddfpCRI5BtQofk9PwK3PVWTV+8YtVA03EA/RQ+DnpA/sPEFd+6CsHINoPTRR+Ia9MfaBLb99u",
+	"uCtkPZBCl5yGzxPLOQx3w68R6dGXonCXDKhoCB+TH6n+R2ex6hgZZOlnKDGRtjhBDslruA9ABIefhU+Z",
+	"pIPZkh/JEdtH+dLvxvOjU+mTUxTu08/huEBNHYJaGXFpJsppDiVnSDWW0blBEKGOfq9L4qmOt10vSBNx",
+	"JMJwqxFNvPxaW95Ow+toeIAqu2gChf9CpTmVAnTNp6bmJmIXAaWD8AmjmBw1TFwEW8LqSBGDOOQeHLvQ",
+	"DuEDWTuk298lx8CPVNt9zMQTOSWnTKvQy60eeXWVsfQuXxhlNSS9qht+RvqqfPtvHl43Zo2/n4oNpimu",
+	"6U/VpQXpNji+OwpfxB/kJ10syJ0WaHug9rXKivNBQuNeqptUKh2RnrwJbA/j/aYqHdtixA4/fBZ+QXpo",
+	"y3bEDDw/YfjR9x0y64tKjb6kijBlBwRPuBs+o5/2GfOeMllVdvdv0z2o8y1Y6rTbdX6JFrGdbH4KHjA1",
+	"zJSk2NS26/i2RtWpOva3XcfHiqL+MFa16CVvzBoLiyuNDxdvL1w3TGML+761QT/1sO92vCZGjhugdbfj",
+	"tGAFKutHr1I/Zi/WitkjuvVfkD45IMd0x/tMfA9Ap+vFimT4Vfgl6ZNX6ObKytIEO6xwjzLN7KoTzRhN",
+	"oMuVy1fRSq16q1H73dzyyrKJPqrOz10HC7FRq9cX6/BQ5Sq6vVC9vXJzsT73hxr74fTVVWepHv2uOl+v",
+	"Va//Pvp7qd64VavfqF03ER2wurw8d2OB/dW4Vl24Tgepmeja4sKH83PXVkwETzd+Pb947Td8hF9dXXXm",
+	"FlZq9YXqPJpAVyqVSbiZhA4mzdswjaW68m82uGEa8ujwZzw8/1Ycn5iLYRrKZAzTUFdnmEZymwzTkHfI",
+	"MA0xc62K18KBZbd9vcpOjoBVDygzMY1FOXaT3Z5MxzihfA1cmdLkT5lFvs/UCe5S4E/06VuBhuDbPjNb",
+	"wucy3xbePRG5F9nXQNHx82mWSzzPGEPHmR9S7bl2HztBHfudtuY+dTtB091SdPWmh6lEgBl4G/APe8Nx",
+	"PYX7c20S9YyYAT272qlULjVBzYd/4in2iYe3XfbB37MPQCjCQbGPVdObv4dpbaRHRTh77O/YF7bdYn/r",
+	"dLLYNsqx/EBGHMAt3Bfa6QDu0C+ZyZd+c+JAxKZmHsmcsGc03o6m23H0G0n+Uyi74LJ4hah21iXHoNju",
+	"gxuwy3RWsJomEfmOOY/IqfBF3LCDeWtNMppv1aU7Mla9+8KzwHxOmidOSU+68dBEcttURTncU81kuyX9",
+	"GHwNKXfClu3YW5Qip3WuBVhh0XUJe029uvnuLfadUDwSe/4fcENTJRt29oYd3Iy9QeJ30Xfz1lohdQgz",
+	"Oxo0nlsmwSxYKo8ynjJMgzGFljFvYqsdbF7bxM17aTqLLtPUz/T70LICa83ysYm27A0PVHRf7ELTddbt",
+	"DR2/+YEVdHx54syot+y2cbdon/je8HfotoatcDkaJKEY0JWr9kEescjbpZHeGUvpONZ9y25ba21cvCL+",
+	"DlNMTbemW3hrDXvzrtXSCIcM52CBW1k4B6n/kRosh2AkMuuBXWJDuO7yxgLX3TCjlHY5x15mPjHhKdVt",
+	"4eJ97LU6WNaP6ea124vrxuydfCqQfrS8yeyS1JXJXh8bAqVJjE+MK/CejswUGyjbc0C6ivw0Edxer9Dy",
+	"fJX5Vh6H+9RiIaeF4kg2DdJLS+/v3XiHo4WkKZW75IfybtKRM+M+L9ii++Co+ZocU+fUY3DcL89XS4d3",
+	"IsdswTgpU7DL/PbMGHwe7kXbT08E4m+fw516TPolXNWZPx7kRHqYNkLVVhHfEtrImOM7MbPJxxidj47l",
+	"EryWQQwKx2R4m5KLzjHNebzvgOkVzEC3PilnoF8cSnW3OsGmm6k9cIW5mk3qTqfNrggeVE29Yt31mrjB",
+	"9e3U5izVhd2xB85Tqmc9YfZtvn2Sjk2nXVRs0PNMvkxUQnkm08cTBfUi56nVatl0F6z2kkJTGhMxT1bG",
+	"zlTqxE0TGf9Cw/YXBGVOcGskkpcXryLQw1+SQ/pk+KXsmWV+NlCCX9IpAPuCCyKHvfu6YFVa8Vhcqi0I",
+	"o/u6VvUr9JX9OWN6x+q2nbK4V5cc0BVSn2jCBabjSw2VJZ3ACYLRkYfMdGasOWlkSYE8ug6+A004xCu6",
+	"rhMOthzppQtoxnoR+GKPwX7sZ8iy8u5XNgeq82K9/5U+oJviN4ww+3yej0E+xHMxlcQJ5StKJ3DphU/H",
+	"Mv3iIIFnyNsdr6rgqMHToc0gyLB18sUu+V9qYDdyh6SF6PmjuRk6X2IKsW9mCN8GnPXnEIPrpmUfXBPM",
+	"kBPnnCANIQuzFBuUdJq8MmUp16PxszhQ0ScvERc5/JMjFnGhvHPIsw/6CVIF0fhm4tmMtho+cFThAYin",
+	"O2tbdsBOQkyrurRUX/wInJnXblYXbtSWG/Xab2/XllfYZ4u3btUWVjIkto//qBn8O+EJU/aX7s1hYntY",
+	"YIOdKhw0PcFdNK01u5LR+ZiqogtYyqmJPus4qU+VjeD8cTdf90ws8X9ELiTuouKTMVMEKB6IwynhM05C",
+	"Keo7Brl0QjXCBNNmmeb4j+IZfZy+QPQwYzEtenIVx/EpTsMrCeO6knX7ogQJU3vi4AfR7a2nie+LzKfY",
+	"FHsaKynhFyLAdhXxGA71WL5KE1K4Fxl6gpjCr6WYf9IAISfaDJ52K7mOcybs5KUjFSzZMKX0OMdFIBgx",
+	"8vB222piehCoaTktm15IyHYQT8gZliiSa9afvhrZ1S1M3A2DcFfxINEV/kiOwv1USgMsv58waBLJV5nR",
+	"bSoK41sgGVh820kBbdfS5iN9R3MSqeeGvKTr1QSok3sCAepU+nEZTUxyM+pCVe59XN6tVZQTkBfXLxWn",
+	"ZtMRG6enOVAsU7JmzW3t5FuaGUwZqQPjud3FLTmEfpjYGXnCYnomW1/i/dn7k+lXDhpNa9tq8ohUUhpT",
+	"ouRuXMkRdSSireQFS7ggAxOJPNdSuc1fSQZmlNwUfsUEsd5XYX3ScLexsEB1TPRtnLbJA/s9OsZAu4gL",
+	"4Cz5PM7nf50SMRevogr6f4+/FVlvujdleLcLZvqfceqakqUSPlGyVPR79yx1UekMsxO9+lcmYXIYN6Hi",
+	"QpYXrTkxU6G2PFpdFiyoEmuWov5nEeiAtBqazcG3gabIHYd76f3p0msBUvhOeBVFV6T56aykqyhi8iiv",
+	"Z094hMEOEjKCunl0QkLIkqXawvW5hRs0UeKNS5VC790I582OQHd2tIwmfWRbcNmUv1DoW9gFNcbrREwi",
+	"a9p8wNTkbb/BlClpOEkklQ0rl93bThwVjkfOmvMyDgLb2dAEP9etdnvNat5rZKWh0gKIM8h/ZYkvSV/5",
+	"ITPJH4fPyRE5jrN0medoj9I76TJVCH7LjLjIoKc5v+HjWJynuC98Kiv0qhojsu3DJ1ziiYRg/nUf6if2",
+	"kp6n4gyc4uvjB3LM5e2xTjCruuqA/MgmBqYnVFBo9NbU1orEv0N424nwQJe9aKLEiIpOuCsRkMIF6lMe",
+	"1Qt5j182aKkONEHrRE7gHZ/z2X2FLsxcLJ6Z7TSs7W3PvW+1S8ys2GnKCO01+ZGcim0dgKe6D3Nm02WW",
+	"xpESGKG7Xmq6eRv5P8HP0oeDjWsCpYT0zL1FS/WrEu1rqFrJOjXFxtMPlfzvhJu/aEVx8JOGzhtbttMJ",
+	"sF4zYbv2EvGgZMQPJ6TPlD0lsUDrUoxIJPySX6pFQdWvcsOqItwn7UzkjWtbYjWxugYPKzHb8FmJLQKZ",
+	"3GhjqxURq1bLhMquRLDtFNKtTslAJsZeKiAgVm8qgpM6KsABKhcgvAaqGJCTvFhmRuZ5cmv0l0DOEesc",
+	"cIzfIKNMuOEOOb/JslGIi4S1zo6GJyn0uDsZdLYfea5IL9L+iw6Km0N+4FkB3tAZLj+wkg6QJC+42zR8",
+	"SuPFTPnT8KfMlxEBMWc1XHa0XETo4+G+mC2q1z6aq31cqzeWV+rVldqN30vKnmc5LXeLWq/Y8oMGtWGZ",
+	"A5WmOTc8d812oOLJ3tgMMrynI2k8Op0hM39cW3V5PyvHpfiizZedpdPoteZLsU+t0I2VWl1yytrN80dQ",
+	"D20fZIk2sbBPjhKLv8rUhz7kVh0q8WQlzk+TPxPCRy+5RjSi/0O2ivWmc45qFIngI9kWZ5pSxsuSPqwy",
+	"zN9gPKMLdVImzq6oFnW5u6p8IH0kuBBdkC+RlDpUrOuMamKf22aQLZ58++FjXmOZkVNPzkDaHYuUYjUA",
+	"RT3soJ89lrKBM5IhUv5WHuEZKlaK74sKqlIWJF8cCwjrat68tnb3eeFp2RLnxIlIv2ZDRPM25UXnnMZ1",
+	"3LbvY20ZfBDgre1Alr8SvY2ypS021mi/2hkCz0FE5Yc5MPhRRnSIFT1C5jvVlWl05JCSIzc9I7VkQI4T",
+	"ZGsyA4aciqgKExHMZIsca1SyHbDiUDnjnT57KLQ13da06f2ek1iNPwka/BiHyxOwdtqupU9Q6DEHYgKz",
+	"INYr48rCM56Vq+iXZzGrQ+D4ONzX5SB5vLirEccZ1ZmkCqck+wCsXZqcCqOdwVlAmmRF9gBERVmkm1XA",
+	"L9FUOt4Z+9Su1+bnPqrVwYH2YXVuPsN7dj5Wl9nAVBk/Il3+TyM+QTmRSfBzmjAUSkpvfllZEmXDiC3a",
+	"9ibjSP+2NxmFxjPhNOjFMilVCYqPfO53anS2W/xzevEoj+r2/GPXu2c7GzfdjqfxWWGnlQv6AgR8wIOi",
+	"4KU9ooadiW7enL11S1LdeSE3sxEBj8SkgoB5WrjRCVY30LyiZ/GrDshWKgzkODvPMooctFHO76IS90HB",
+	"1HVvpcLgn1wHa83zLog1VgTEMmfCXTRXXahqit1qHbrJU7dcv+k+GC4vmBI2vteydnQ64l+iFfFw0Cmt",
+	"tavQ+vxDYH2mHOyyjSM92VO3ZX3ClKcPChWpvDwxKQIhdkuasjgZgKHRVdFR9dxZd2HhdgCR86U6EuEH",
+	"VI2CmmgZe/ftJkYXVrAfoBXLv2eiD612G81UZq5QbfA+9ny2L9OTlcmKiP5Y27Yxa1yarExeAikQbMLy",
+	"pwATgv5rAwf5OTGaSPMBuE+jfAVwwL5U4Gyo0pYFZ5NwOQhylKsh++T15KpD/jf9R/iMKsnMvXYQ/jNI",
+	"877w1sAEwEPDvEXkT1cRfLsHH1HGeo0UN2cf3Vn33C0TBe5FVp1KBQAkcM21jFnjBg4AC8gwFXyuOw+1",
+	"qFVpwy4HSivDA06VXFoj+pJXj3fBFDgkXc77vdGhtHInUwQSNvSP6b4qvysXZM6YiTu2V7XtLTtQ3tbC",
+	"6xYUo05XKmYsC65UKpI00FT9PbobX4bARzOVCqsAdwJ+1Vnb2227CfQ09Y88qyYeOHndBJ49RJqDBFJV",
+	"JJjEq/VCJ5sOEfkx3KeAJyzZmo5zecg15q1ALdTXzeU7atSBcHjMamshOvQKhZ/GwgBW6+Nmx4N8gTsP",
+	"jWpry3ZW3HvYMWbv3KXH5He2tixvR1iTryO3xjNliQgKV4/oN6SbEnaQyZdKaUwolv3wCYDl0CDYHQYk",
+	"ZtylM5xqCjgcf6qF25jHkl3m7VLFzpLrBxF6jn+dPc2OFPvBr3kSyYh0JuHuFPipMrFR1CdpIPeRnhU0",
+	"qb/goGVKiIzvNWDEdfktEpc6m9i38oociSkNRVl/5cvhdHUmv16iifhg04TRtn35Hk5dRvFv523AqhpB",
+	"/khoGBTeil1nEVEYU1S+eY7Vntq2dgB1Y8qI0IPuGOJD4+4jU/7ZLyb9P7aNCL3njtH5pXEXivOy6JAP",
+	"XlLaqWBSRQKPvbuUuJOpoKuLM7PocVfOBT+vyDlTxqTnjwCIwEcXRFKxWiEUfgp0CTPUeDGTti1XEo5Y",
+	"VVb4XGQdKSR5sSRN+jgoK6mWcTC0mJLIcSQiLCkOEvRTWoKNRRZpBtcU7PBMTVacwEsvFRny/ly/Co5Z",
+	"DC0EN+YhVFiIR2W4Lmo1D8k630bMp5Oq0dhRXFU8pgEJS7IdTfWlqvMJAkBTeU0iVS2LO6CSg9/iCnZG",
+	"Jo8AasJ19QfjutBHw54o59RPgUOMTxn4gQyY4OLu0ddxaPodKwbZMxu3khDuZg0lkR8cnUJ5HG6jmOJu",
+	"sAf11usmtlrYiy2j300wNJGJGncTDgEMnXLA3qpem1i+WZ258gHjstdS4CYK1JAeujG3cvP2rxsf1359",
+	"c3HxN43l2rV6bcUws2ZIp7dsbzhW0PHwxMyVD3JN07ujs9ioRF7+tougjqR6p1QsWaAVWc0tHN2Ff395",
+	"xih98aUwl7IoXoIYGvAC2wPwzXRZConA4EnBD70P1xJLdtoT8Ye+AmvAIbYyuI1eBMJJAkuZfps2iBTw",
+	"eMZmyb1jXzCWOePQitSBdRzui6Vpwp2nLM0Pnu+RUyaXJCU0fMw9ZZHgiU9cpjvuwpNRpAXOUJFQalsl",
+	"hVLbGkoota2xCCXyrZQvxF3WZxz9mm0KFUfz1VgcrSz+BsrW8qfGZP3PXxBRuLPtthVQ91skjP7ul3+T",
+	"RX+TRWOURbdoGBDxTDB003XvZUmkeStfInGYbq4mZ/lUZAQ8G/vGWJ266hRKeTpURL4iT4c0QCl3R4Zu",
+	"e26fRrYmm4MFHMPX8eOU6T/vZH0cDGF3LUtPn8M9we2sGOxOzHTW6EzLNtKsYbXtJp7Y2BxSNMan/nb9",
+	"E5rByxtriSLTdyWE064HOC9TgpuWeuQwyRWjW4q2JtlimcK6hvsQjTuB1/DAwMk7cFxnl+Cp1umQPJ08",
+	"ZOFyychbVLEnUwyMLkDR/Jeklyp/zBMWUoq83C5HJws2ASJxqs2zXbMEPENSnKdPjVW2S0k/QkgwEMa0",
+	"S6WMXGYh6j4lNcg+112a0e3LMPZiDPUB9XGFu+Eu28BIJUJyLhOv1ZB2k22Nup0etlo7xftZh8fOq3sK",
+	"UMw7D4VWHaF3iu2le/rIjL4XyJ/ZT8SIoMln7iZ/U5IlFWBP3el9Q3WghIIUK6icG8OndMQrlUtvbIt4",
+	"sp/Rsq02CprbaHrmlzT/Y3J69srlSzOzFBrVwc0g+oftOsjD6x0flP6cHQaI1PENoT8iNoh8SDKc6fhO",
+	"6/9AocdzSOkT5W9J9TZK+1OOkHQLuJKWSQ9Y0ZSM/80SoHh5FtOXWK43dElDF8jX5FuT1bpAPjqDiWDZ",
+	"MeQUnnoR7otvVImo8PB2XEQxxaw5WT/SADyku3iomIX6qrHiTgPiDQ24YZXMSnLKhBTUsaw6F4Rrvsej",
+	"BN3wiQrrNjmpzOniJCL/GiNXqpgUheVr6qtNioIvAc9FeUz0HlPK2WYR1cLkoaNiPjpi+BQ1Ny1nA7ca",
+	"63Yb+5DhF3eV6WbACqB0w5VVBwA+oMpQhP0GPC6ZiGGQEyRF6kwkAciQQ4Cc77FBTvglzJMNeWaVlDUV",
+	"1c1mHKsuOYrq11LRzjVGbudQsCV0IqZRp70Q297EdKUyrcUDmjWqrRbyseU1E4r3MCBIyilqexzypBAB",
+	"IkZ60TlBtxzegy1xqnyHpeNT4r5Z1MFojGbDnQzV9OmNoGACK+ciR+ilhYa/Ex6C44Kqq7ykzHMiR40W",
+	"0JoeMrLtZQHP3jE6M4ZpdC4Zd+VZnZ8BpFsUsLce5XDEkNiTZRRaRaqS07dvJEVJq1OZKKKKXna58qvh",
+	"zjTZSkbuYBK3klmq0+YCVhu0aoQ/sf3AT5zFuda5VI9NV9XaAlV/eAPwZeQOpwWz/bwKaEVoJYtuZQFQ",
+	"cLlIyoxEZ75GpeEmSZZlIv36Bh45abd8hOHuG/TJpCFjs7hM2vS3zmZL9TQ/FRKd+RCKV8u6Fvkid5OY",
+	"tuQki9xUMMqegrlXntg2bT9wvZ3svPgfFAzMpTq76dTsMVaEyKA3TpmlwPyts0m4456J9BDrMhAq6enX",
+	"DImoErDqgCarxk+iBBQ36U2uOvR+wLxTirqzDPAJyia4Nsmm/jpCp+4lke5EL8JoP8jJVcRyaRKhNgbR",
+	"uouSjJ2Rfy8d0k1+IO89XycSu4erFU0BCI+o3EXAVMPc6/maVFQ9moW4pLmH/6QAPC/Vf5YiSoGx/oqu",
+	"srSUgaq3HCP9B5lrFScFR/lSa/HD/bybdlbq9QeNOiVUGx0yaQw6IUp2qOGSrgIyVx0Jl53yvIKuk8Yf",
+	"eYXAvREDLqlNJPTwAQx5Jn4VzOW1BreAlQtRC+wFAkxtLvyi4lkuq3MaF5C+EtmQi1QPkYzTPYlU3+1p",
+	"1ilxy6+76oA9x1vLcp/4C0ArpHfHvzBli7V8Zn1g98kZ7T7LVqKiusjoBX3mnRfwSBFFQr0izW+kfWpf",
+	"Am52HIxOYsZATWwsqOlkC0okcqq9SngNINp7rqThTBMpx+CBrVRqf9atto918BTnhvc4R8bkmzcw424b",
+	"Bq0dnJiuTMxcXpmemb10efbKB38YmwnKcZ/fvhEKulFCv+kjMZ334ioag+2ZbHgZNai8w/1ZPuLHhFto",
+	"bQcBSUwjdx3NCFHbQpG4Nu7KFiyQCNp223ZzB9k+9ET1rcD2123qFc14/1WU/foxmr/sJo7kdjeNgaXr",
+	"kh95qcV9ooG/k2456ulLxH/lK5Emiw+fPEFdsnuxhUMnT445YaIL4L6mszmDcAKDA2U1ZyCge/pYQIGu",
+	"wftplTSheVettLqd05JgqS5dLrHxz2+1Vxp06JHrTu+eW2ZK0ostLCUeNb3V7iS6icVys7JS+dVspTJb",
+	"qfwh7k4lfT+tfK+0/oofmlEekjJMZiAyNkaHoIIKZFBwTuy0jNySrcSODddmbpj+0OpApeu4BqwJm4SU",
+	"33sXFkey21NS5JPuCPJCMTd0bcTTiKYpINQIJZghm/QF2Kncu248jjpB3fnZWdJLBGj7eVTBVAcEpvqM",
+	"pCHSd+UhUYwB9U0e4t0riRQRonNl/FGIdA+1ziVJ3sCgs4ZIKzYeJcUUrBq6RrQaa5QbOleM8WmRiZfn",
+	"tAFk+CZ63NDizhWeoY5UTqQVNV6UdZrBO9Fmo8bMpTPShtR2eU99Z71tN+HZWCyOS3kzlU8EkPIpd2tG",
+	"0q/PEVvvW+2OVgmXGtDH2nPTdaB5jxOgLbdlr/NlAjkE3g4KNrFQnY1ZCo4OGx23AIuXC1mOsMk8oh8l",
+	"UEvYt1Hf/MxZys31pWlaDtXrhdhGrsM7FcH9SafkuNdEw5b0vMI9xVuRk4uRN7VEc/94dmUbyPCJBlUu",
+	"4hITzc+bBHyv0m0Mchax0qguL8/dWEhssRCEwoYSchgFLgo2bZ/v9Bhto+/kxrtRlwzBJeyI5ISE7FaQ",
+	"I6SHp9/EdRdIaYoqAHNaXTJDTXAue4w5nDhIUKqZaFndJGrOone5/msMhZ3V4Zjlb9F6hAhIVHFwxmDJ",
+	"XXRB68y8aGaht6cScPvgXe1pW0G90ntAk15fAaukdqDQg7uqkZ3DaKmMa0RrzuwFl/D68f4459D1WCcd",
+	"Y4miGGPUpIYayFIHP+C6B1q32wH20AM72EQB0EK+KqNRHXkMRdMMI0cFEU1+UjiRB+Fz8lLIHRAuHO0h",
+	"9Xro2BE12zBHbeT19noKFSq7un5B77VndJypN0qz1jsPc8gq2UUlyyurOgbM+JUx7qT0yCXj0V0xBzaD",
+	"EdlnKBYpt5ZH5TE8SqVEkL/IfWLfv9IUFjxPh9zjmkZRrRJ5y6J2QBDzevUTc1u/gUQn0Xu8tPVRpNCh",
+	"CGA/voel5yLNhbpo2TV33gjy9yW6FQzYGgVU4RBtwvOVIcqy/lTcJC+3PJLm0ftV6dnzCeQ7Dw2eRnol",
+	"UUD3yIy+uqTxfMZkFDkdEzDeGdmp/84jBJ+L1DhtPy3ySgvsO0I3KjYPHRBuwuWpKQJigMU8m6AfoZ2n",
+	"pposQkgVYyZfFO5rX5SRQHVGBllc9ZycZNUeMLIS0NC59AR9/857swuosUTDQJbck+4qcCnZAe+S3gOu",
+	"IUvl/TxSnB6gkhxgusQAM/ked77Gh8O1Y9f30dRQbEnfeqoTaAGBFJFnlHVCawIZYUavD/fTRR1ZKW/0",
+	"nbRig4lwXS+HV1nESg9mymq18h3UtKlZtdU6j6ESNZu7ozTnYFSaXUFcpRXEQHx5P5pRf/Rrdw1kpUx1",
+	"EVZaaTVrJXKljDkLPuDd+N71lkSxrhzGE3MtsVFlOEiNCCmZn0PAquZkAKzUqrd0+efRut9gDnpydXn5",
+	"6GNIdqjO12vV67/XrZYe/JtcaJYHkWHJdWnmGDmJCqALE/OzMu8VX86+kHSa9tMX1Mq1KUDL47m6Snqg",
+	"Dn1BThxYgeCMJBpj6PwcR9k3cQxCTZCL/PKveKEjOWUo96ywcRLpd5IqudS6iOV/HxyYUTnUkWguATpx",
+	"OmNO1+5XRENXHQ5Vvi/XvMFzL3kCX+S2jnafZvch3vk5s6PMkK2447K7LE8ZPY3r8QGc4/aJeonzbquF",
+	"F0NOK3I1U07DGcegYHZFTAK2RLSPTNni/YyeykqrosQgf42IP+5wKBAdUs7gkfuSvQEnVMxNLOTLvE9w",
+	"v83QGSSOycOKcUYrv/GDZGj7l5r+94W+K3p7Dhkmn+HJKq4zTCQmSwsxjUSXSNDfU93d7txNdW2bzpvi",
+	"EF6kiLFs16njbdcrc2OnxVLkDe9KfghO8urDvJq7vNsm5+6j0aUPF28vXE9d8hBTWqdN/JDrIceFW8in",
+	"wSVJlL+5S//8SS7f6neY47mKhkrZWELJAEhfH8+KEvaf6NJo8q7EguIz+ry26ky3zfEjoEVSYNbfQhLc",
+	"+dPa3hvtenh7QxMx+WeGqpaU7z/BlK5hS0pK6oAFFCu35C6g3OjRd03BmmaqyW6l57dwo9VmRc5Fdfor",
+	"Vg/y06C/HHo6LVpTDiF5eM1qW04zzxiIQ/5QJ6R40NPtOaHC8IxH8aMGQCeaEqRe+GX4NYq8Oi+iXg1S",
+	"nJy8NmEIqmZ2yQmMwMpSwK30Y5zxsOqkvUtJvmIQJ2x/Y6CvnvaN5CSV+QIZRWdMH2adtqAANPyMHtwB",
+	"u78AiSSyklQnPq1eilsusSpsNiTSpgD0tKmfpzHy3r7AlJAHGcqwyTNU6hFt/Ne1U94vY0PdSyozuZuc",
+	"9lk3ZmdMYw2vux5OBlq4s5s9NR0/Na2JN6efqmhCzlvufZxpwFwayYA5t3szotcc5f+bRHIlYzO52wAn",
+	"G8US4E+dyYLwp6mrqKrJN+FTvsoo4zDpspdF/SHr106D1UNcMb6qq+T75JcVbWVkqaNTNNQ+6OPWM94e",
+	"pmehjvO95CqMoYyK1YT3pAuJbqrvJau95TwJne6qOCWpcnAGuSRd8hpsZ3CmMVXmPKnRwwmVCEerrH6K",
+	"LsSocbGoHbB2SULmvhTN66Lq6hSCqCx5wEdCw4DVNR8XqbdMoQPswF3uRN4D5RNuhiPqgDeLklGoc/oA",
+	"Lg0p31KTkDKJgLRekKPMIaVOdOJV5qoTfsob2ByyYkG+ZVQD1PtDuixKwBXrpEdE0Uq1KiC1af1qvIPn",
+	"EMfYaflRptgHE5XpiemZFaiFm61U/nvl0mylYpjGPduhY39UvVZdmVtcEH1VfTnHbGZi5lfpXyaUmJxO",
+	"jHweZTtisynFiZTS3Jbnrv2mMV+rflQzTGNx5Watru1ELC2h7KAjpKbAPOXBzGitb04zLSfXBPlobyod",
+	"+Sfbsr1H7bE4n/UZsgQHyacW55mGlcPnbOI/BwDpdLcueSM0S0cXeC/4fQopZkbmMbf2YTtl2X3bj5tw",
+	"MdnNmnBpxHeGpLquPD+uFlwWe9+IzdulX4+vvZaOad51Y63v9ffYmPtuFtBcCXJK9YUvQ1PKj8ZFWEML",
+	"+fERkNpQnbvInio0FD79+cgulYrCx9rVX83W7wDuFz5SHYJQ5nGIAHzngEWsebFiASlu4ICLqVznPfzw",
+	"hvTssN57+oK51rl89wVicYie1kILSEOejaDuRMMPUW0LcgNgwTW3FXNYqw3kZZDnnzI3DBueOiu3XRmd",
+	"6nPJvh4V6BURPX/yHZD8cDgi4ywmulvekBkNr0OqGFjeBA/pWLhxBEyPH5gtD9AUS/V/iJLTtUQ1dtTR",
+	"fwifmghM8F6uWV+qXjiX4JP6RhHZJ1SN90be5wFmPGBzbmyKRVrt9uJ65oQFMSpLpdOlNfLQD4KpMpnk",
+	"po5Xitz+knHn0yFlsMJMWih97//XuScyFKnR7gba9suv8tyUbE8dizxok7ByDu8Qiao7SNrVpK1qmxMh",
+	"VlAUJSilA91Xaa6c6wWrDhnIzknmeItf+fTdJJvCNi9LOzu+MK6UScSjsWVvrncQ0JUm+1DztZICUTQn",
+	"RoEa6jtViFLE/rOYAfzFRaE3LRqCKaoVk/kbupWPcJnHm/VWQtqMgbIzaUdOos2IQc+8l0m0UgFZMof2",
+	"Lj9GUPJSXFeiCi2d1pfDnfFxDJtoG88y75dUJGmJD3YR3lTqQteFOF9lXwEXGIR6JIInOBMx1KAB9AHk",
+	"aOi9cO+i6K3GatCQUppAm5y8/Q6ugMQzRafHdU++YBoCOoGOq9C4AaoyQGz23kVnjjfmwVGw6rn68SmH",
+	"gValbtSXazQlpFyigrhYx5ep0HiA7Y1NXYF0Nq/aPuDO6C+1dBHrw9xeO2ZyIkWPv03/5XCVwwlJKe6M",
+	"5DaPW3QOJf7GLeH+lrvxNuRQmcyGLIVvTDkOWqk1ZFRjOeVnOE9g35g1pn/FwvAQfqZ/V9jfNMD9T66D",
+	"jVmj1qHMMnXL9ZvugzSfPcD4XsvaoUucNmfMS+Zl80r5wh/VlfB2w9vpsdO9ZCKT7Ws5sVdnPb9Hbe6p",
+	"dbrLyFH0sfn5cPK/8eSZURwZVyERko3Pio2oTTpUElAGQz/Aa5uue8/XNPhMs/LH/OHzd2cUbWwomukk",
+	"Gxrcut5kZAcJkwvDPe7jpocpo/uX4B+m0fHaxqyxGQTb/uzUVHPTCibW3GCS6gueY7Wn2LLE63JTdIbr",
+	"qcN3IbOfjpiqBjwGupKgm7eq1yaWb1ZnrnwgQNu0bS8EAsfEsr3hWEHH06fueO0SGpHXVlrusCm+6zwd",
+	"vpOZbHjEZcHxOWAO3nR+zu36vKl0q8qA36IuPQFUOQJit9gKJj9u1+dFXxa5TZbE44JVk2zOchLKsTlL",
+	"RRhb+gGfw4h5LdKvx5eWkCIyOaflHUVg5fmcP90+lc8iDUD9fYdRO98e9H2TuvMwIJxe+HUUi4BsrAE5",
+	"Lkdn9n3s2fn5BhKliadL9WOTiEEW6yVoSv8+HhWV3yXSL2MMxOu1+bmPanUAI/ywOjefgV2pH6Ftb9mB",
+	"MkDk9p2uVMB4ZpbvFfhL2MHTmjWMN8ymHtQwFyA/tJ1CcChpiFKetm8laouMufdB1kPvdFpwtxc+/jkI",
+	"h1QehtKbK8H0ppIlzrqax2DhUHzYD5+UEA1t2w/KCIV5+txYaV1MYVhKL6Tw6MUlk4Pkg+yf99DOEq8r",
+	"PgGIFOyUUwLq7NlxKQFcFuyMqAXIP39PtNdYDBaKsq6S2EeRjQBIknUzfwdOq+TsxiNRRCHPqagBiWDh",
+	"Gb3KcoXqIBfCXRb1ANlyzNsGgk5Lc3UYDFW4L0CooDYFgSkDuFjaxooXM7jgUfTxQ3EzsxKeR2b0ATOP",
+	"pQ8U1FPpc44BKH1yzW3hRWjCL38aTUD67EPX28DyB9VOyw4o9Mz/HwCN7UF+zPEAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
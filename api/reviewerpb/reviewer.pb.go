@@ -0,0 +1,2217 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.9
+// 	protoc        v5.29.3
+// source: reviewer.proto
+
+package reviewerpb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TeamMember struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	IsActive      bool                   `protobuf:"varint,3,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TeamMember) Reset() {
+	*x = TeamMember{}
+	mi := &file_reviewer_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TeamMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TeamMember) ProtoMessage() {}
+
+func (x *TeamMember) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TeamMember.ProtoReflect.Descriptor instead.
+func (*TeamMember) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TeamMember) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *TeamMember) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *TeamMember) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+type User struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	TeamName string                 `protobuf:"bytes,3,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	IsActive bool                   `protobuf:"varint,4,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Вес для стратегии weighted (0 - не назначать)
+	ReviewWeight int32 `protobuf:"varint,5,opt,name=review_weight,json=reviewWeight,proto3" json:"review_weight,omitempty"`
+	// Лид команды; учитывается политикой слияния require_lead_approval
+	IsLead bool `protobuf:"varint,6,opt,name=is_lead,json=isLead,proto3" json:"is_lead,omitempty"`
+	// Личное ограничение открытых ревью; 0 - действует ограничение команды
+	MaxOpenReviews int32 `protobuf:"varint,7,opt,name=max_open_reviews,json=maxOpenReviews,proto3" json:"max_open_reviews,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *User) Reset() {
+	*x = User{}
+	mi := &file_reviewer_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*User) ProtoMessage() {}
+
+func (x *User) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use User.ProtoReflect.Descriptor instead.
+func (*User) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *User) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *User) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *User) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *User) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *User) GetReviewWeight() int32 {
+	if x != nil {
+		return x.ReviewWeight
+	}
+	return 0
+}
+
+func (x *User) GetIsLead() bool {
+	if x != nil {
+		return x.IsLead
+	}
+	return false
+}
+
+func (x *User) GetMaxOpenReviews() int32 {
+	if x != nil {
+		return x.MaxOpenReviews
+	}
+	return 0
+}
+
+type Team struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	Members       []*TeamMember          `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Team) Reset() {
+	*x = Team{}
+	mi := &file_reviewer_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Team) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Team) ProtoMessage() {}
+
+func (x *Team) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Team.ProtoReflect.Descriptor instead.
+func (*Team) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Team) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *Team) GetMembers() []*TeamMember {
+	if x != nil {
+		return x.Members
+	}
+	return nil
+}
+
+type PullRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId     string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName   string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId          string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status            string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	AssignedReviewers []string               `protobuf:"bytes,5,rep,name=assigned_reviewers,json=assignedReviewers,proto3" json:"assigned_reviewers,omitempty"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MergedAt          *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=merged_at,json=mergedAt,proto3" json:"merged_at,omitempty"`
+	ForceMerged       bool                   `protobuf:"varint,8,opt,name=force_merged,json=forceMerged,proto3" json:"force_merged,omitempty"`
+	UnderReviewed     bool                   `protobuf:"varint,9,opt,name=under_reviewed,json=underReviewed,proto3" json:"under_reviewed,omitempty"`
+	// Команда каждого назначенного ревьювера; заполняется при создании и переназначении
+	ReviewerTeams map[string]string `protobuf:"bytes,10,rep,name=reviewer_teams,json=reviewerTeams,proto3" json:"reviewer_teams,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequest) Reset() {
+	*x = PullRequest{}
+	mi := &file_reviewer_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequest) ProtoMessage() {}
+
+func (x *PullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequest.ProtoReflect.Descriptor instead.
+func (*PullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *PullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *PullRequest) GetAssignedReviewers() []string {
+	if x != nil {
+		return x.AssignedReviewers
+	}
+	return nil
+}
+
+func (x *PullRequest) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetMergedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.MergedAt
+	}
+	return nil
+}
+
+func (x *PullRequest) GetForceMerged() bool {
+	if x != nil {
+		return x.ForceMerged
+	}
+	return false
+}
+
+func (x *PullRequest) GetUnderReviewed() bool {
+	if x != nil {
+		return x.UnderReviewed
+	}
+	return false
+}
+
+func (x *PullRequest) GetReviewerTeams() map[string]string {
+	if x != nil {
+		return x.ReviewerTeams
+	}
+	return nil
+}
+
+type PullRequestShort struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	Status          string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *PullRequestShort) Reset() {
+	*x = PullRequestShort{}
+	mi := &file_reviewer_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestShort) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestShort) ProtoMessage() {}
+
+func (x *PullRequestShort) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestShort.ProtoReflect.Descriptor instead.
+func (*PullRequestShort) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *PullRequestShort) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *PullRequestShort) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+// Последний вердикт ревьювера с момента его назначения; submitted_at пуст, если ревью ещё нет
+type ReviewerState struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewerState) Reset() {
+	*x = ReviewerState{}
+	mi := &file_reviewer_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewerState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewerState) ProtoMessage() {}
+
+func (x *ReviewerState) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewerState.ProtoReflect.Descriptor instead.
+func (*ReviewerState) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReviewerState) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *ReviewerState) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *ReviewerState) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+type Review struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ReviewerId    string                 `protobuf:"bytes,1,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	State         string                 `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	Body          string                 `protobuf:"bytes,3,opt,name=body,proto3" json:"body,omitempty"`
+	SubmittedAt   *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=submitted_at,json=submittedAt,proto3" json:"submitted_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Review) Reset() {
+	*x = Review{}
+	mi := &file_reviewer_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Review) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Review) ProtoMessage() {}
+
+func (x *Review) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Review.ProtoReflect.Descriptor instead.
+func (*Review) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Review) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *Review) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Review) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *Review) GetSubmittedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return nil
+}
+
+type PullRequestDetail struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	Reviewers     []*ReviewerState       `protobuf:"bytes,2,rep,name=reviewers,proto3" json:"reviewers,omitempty"`
+	Reviews       []*Review              `protobuf:"bytes,3,rep,name=reviews,proto3" json:"reviews,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PullRequestDetail) Reset() {
+	*x = PullRequestDetail{}
+	mi := &file_reviewer_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PullRequestDetail) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PullRequestDetail) ProtoMessage() {}
+
+func (x *PullRequestDetail) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PullRequestDetail.ProtoReflect.Descriptor instead.
+func (*PullRequestDetail) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PullRequestDetail) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *PullRequestDetail) GetReviewers() []*ReviewerState {
+	if x != nil {
+		return x.Reviewers
+	}
+	return nil
+}
+
+func (x *PullRequestDetail) GetReviews() []*Review {
+	if x != nil {
+		return x.Reviews
+	}
+	return nil
+}
+
+type AssignmentStat struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Count         int32                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentStat) Reset() {
+	*x = AssignmentStat{}
+	mi := &file_reviewer_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentStat) ProtoMessage() {}
+
+func (x *AssignmentStat) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentStat.ProtoReflect.Descriptor instead.
+func (*AssignmentStat) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AssignmentStat) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *AssignmentStat) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type AddTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Team          *Team                  `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamRequest) Reset() {
+	*x = AddTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamRequest) ProtoMessage() {}
+
+func (x *AddTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamRequest.ProtoReflect.Descriptor instead.
+func (*AddTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *AddTeamRequest) GetTeam() *Team {
+	if x != nil {
+		return x.Team
+	}
+	return nil
+}
+
+type AddTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AddTeamResponse) Reset() {
+	*x = AddTeamResponse{}
+	mi := &file_reviewer_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddTeamResponse) ProtoMessage() {}
+
+func (x *AddTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddTeamResponse.ProtoReflect.Descriptor instead.
+func (*AddTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AddTeamResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type GetTeamRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTeamRequest) Reset() {
+	*x = GetTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTeamRequest) ProtoMessage() {}
+
+func (x *GetTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTeamRequest.ProtoReflect.Descriptor instead.
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+type DeactivateTeamRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Вернуть план без сохранения изменений
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamRequest) Reset() {
+	*x = DeactivateTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamRequest) ProtoMessage() {}
+
+func (x *DeactivateTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeactivateTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *DeactivateTeamRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type DeactivateTeamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        string                 `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Report        *DeactivationReport    `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateTeamResponse) Reset() {
+	*x = DeactivateTeamResponse{}
+	mi := &file_reviewer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateTeamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateTeamResponse) ProtoMessage() {}
+
+func (x *DeactivateTeamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateTeamResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateTeamResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeactivateTeamResponse) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *DeactivateTeamResponse) GetReport() *DeactivationReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+// Замена ревьювера; если замены не нашлось, new_reviewer_id пуст, а reason объясняет почему
+type Reassignment struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldReviewerId string                 `protobuf:"bytes,2,opt,name=old_reviewer_id,json=oldReviewerId,proto3" json:"old_reviewer_id,omitempty"`
+	NewReviewerId string                 `protobuf:"bytes,3,opt,name=new_reviewer_id,json=newReviewerId,proto3" json:"new_reviewer_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Reassignment) Reset() {
+	*x = Reassignment{}
+	mi := &file_reviewer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Reassignment) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Reassignment) ProtoMessage() {}
+
+func (x *Reassignment) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Reassignment.ProtoReflect.Descriptor instead.
+func (*Reassignment) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Reassignment) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *Reassignment) GetOldReviewerId() string {
+	if x != nil {
+		return x.OldReviewerId
+	}
+	return ""
+}
+
+func (x *Reassignment) GetNewReviewerId() string {
+	if x != nil {
+		return x.NewReviewerId
+	}
+	return ""
+}
+
+func (x *Reassignment) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type UnderReviewedPullRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ActiveReviewers []string               `protobuf:"bytes,2,rep,name=active_reviewers,json=activeReviewers,proto3" json:"active_reviewers,omitempty"`
+	MinReviewers    int32                  `protobuf:"varint,3,opt,name=min_reviewers,json=minReviewers,proto3" json:"min_reviewers,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UnderReviewedPullRequest) Reset() {
+	*x = UnderReviewedPullRequest{}
+	mi := &file_reviewer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnderReviewedPullRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnderReviewedPullRequest) ProtoMessage() {}
+
+func (x *UnderReviewedPullRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnderReviewedPullRequest.ProtoReflect.Descriptor instead.
+func (*UnderReviewedPullRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *UnderReviewedPullRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *UnderReviewedPullRequest) GetActiveReviewers() []string {
+	if x != nil {
+		return x.ActiveReviewers
+	}
+	return nil
+}
+
+func (x *UnderReviewedPullRequest) GetMinReviewers() int32 {
+	if x != nil {
+		return x.MinReviewers
+	}
+	return 0
+}
+
+type DeactivationReport struct {
+	state            protoimpl.MessageState      `protogen:"open.v1"`
+	TeamName         string                      `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	DryRun           bool                        `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	DeactivatedUsers []string                    `protobuf:"bytes,3,rep,name=deactivated_users,json=deactivatedUsers,proto3" json:"deactivated_users,omitempty"`
+	Reassignments    []*Reassignment             `protobuf:"bytes,4,rep,name=reassignments,proto3" json:"reassignments,omitempty"`
+	UnderReviewed    []*UnderReviewedPullRequest `protobuf:"bytes,5,rep,name=under_reviewed,json=underReviewed,proto3" json:"under_reviewed,omitempty"`
+	// Только при активации с rebalance
+	Rebalance     *RebalanceReport `protobuf:"bytes,6,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivationReport) Reset() {
+	*x = DeactivationReport{}
+	mi := &file_reviewer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivationReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivationReport) ProtoMessage() {}
+
+func (x *DeactivationReport) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivationReport.ProtoReflect.Descriptor instead.
+func (*DeactivationReport) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *DeactivationReport) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *DeactivationReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *DeactivationReport) GetDeactivatedUsers() []string {
+	if x != nil {
+		return x.DeactivatedUsers
+	}
+	return nil
+}
+
+func (x *DeactivationReport) GetReassignments() []*Reassignment {
+	if x != nil {
+		return x.Reassignments
+	}
+	return nil
+}
+
+func (x *DeactivationReport) GetUnderReviewed() []*UnderReviewedPullRequest {
+	if x != nil {
+		return x.UnderReviewed
+	}
+	return nil
+}
+
+func (x *DeactivationReport) GetRebalance() *RebalanceReport {
+	if x != nil {
+		return x.Rebalance
+	}
+	return nil
+}
+
+type MemberLoad struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Before        int32                  `protobuf:"varint,2,opt,name=before,proto3" json:"before,omitempty"`
+	After         int32                  `protobuf:"varint,3,opt,name=after,proto3" json:"after,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemberLoad) Reset() {
+	*x = MemberLoad{}
+	mi := &file_reviewer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemberLoad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemberLoad) ProtoMessage() {}
+
+func (x *MemberLoad) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemberLoad.ProtoReflect.Descriptor instead.
+func (*MemberLoad) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *MemberLoad) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MemberLoad) GetBefore() int32 {
+	if x != nil {
+		return x.Before
+	}
+	return 0
+}
+
+func (x *MemberLoad) GetAfter() int32 {
+	if x != nil {
+		return x.After
+	}
+	return 0
+}
+
+type RebalanceTeamRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	TeamName string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	// Вернуть план без сохранения изменений
+	DryRun        bool `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalanceTeamRequest) Reset() {
+	*x = RebalanceTeamRequest{}
+	mi := &file_reviewer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebalanceTeamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceTeamRequest) ProtoMessage() {}
+
+func (x *RebalanceTeamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceTeamRequest.ProtoReflect.Descriptor instead.
+func (*RebalanceTeamRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RebalanceTeamRequest) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *RebalanceTeamRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type RebalanceReport struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TeamName      string                 `protobuf:"bytes,1,opt,name=team_name,json=teamName,proto3" json:"team_name,omitempty"`
+	DryRun        bool                   `protobuf:"varint,2,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	Moves         []*Reassignment        `protobuf:"bytes,3,rep,name=moves,proto3" json:"moves,omitempty"`
+	Loads         []*MemberLoad          `protobuf:"bytes,4,rep,name=loads,proto3" json:"loads,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RebalanceReport) Reset() {
+	*x = RebalanceReport{}
+	mi := &file_reviewer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RebalanceReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RebalanceReport) ProtoMessage() {}
+
+func (x *RebalanceReport) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RebalanceReport.ProtoReflect.Descriptor instead.
+func (*RebalanceReport) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RebalanceReport) GetTeamName() string {
+	if x != nil {
+		return x.TeamName
+	}
+	return ""
+}
+
+func (x *RebalanceReport) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *RebalanceReport) GetMoves() []*Reassignment {
+	if x != nil {
+		return x.Moves
+	}
+	return nil
+}
+
+func (x *RebalanceReport) GetLoads() []*MemberLoad {
+	if x != nil {
+		return x.Loads
+	}
+	return nil
+}
+
+type SetIsActiveRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	IsActive bool                   `protobuf:"varint,2,opt,name=is_active,json=isActive,proto3" json:"is_active,omitempty"`
+	// Вернуть план без сохранения изменений
+	DryRun bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	// При активации выровнять нагрузку команды пользователя
+	Rebalance     bool `protobuf:"varint,4,opt,name=rebalance,proto3" json:"rebalance,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveRequest) Reset() {
+	*x = SetIsActiveRequest{}
+	mi := &file_reviewer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveRequest) ProtoMessage() {}
+
+func (x *SetIsActiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveRequest.ProtoReflect.Descriptor instead.
+func (*SetIsActiveRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SetIsActiveRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *SetIsActiveRequest) GetIsActive() bool {
+	if x != nil {
+		return x.IsActive
+	}
+	return false
+}
+
+func (x *SetIsActiveRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *SetIsActiveRequest) GetRebalance() bool {
+	if x != nil {
+		return x.Rebalance
+	}
+	return false
+}
+
+type SetIsActiveResponse struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Report *DeactivationReport    `protobuf:"bytes,2,opt,name=report,proto3" json:"report,omitempty"`
+	// Обновлённый пользователь (при dry_run - каким он станет)
+	User          *User `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetIsActiveResponse) Reset() {
+	*x = SetIsActiveResponse{}
+	mi := &file_reviewer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetIsActiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetIsActiveResponse) ProtoMessage() {}
+
+func (x *SetIsActiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetIsActiveResponse.ProtoReflect.Descriptor instead.
+func (*SetIsActiveResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SetIsActiveResponse) GetReport() *DeactivationReport {
+	if x != nil {
+		return x.Report
+	}
+	return nil
+}
+
+func (x *SetIsActiveResponse) GetUser() *User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewRequest) Reset() {
+	*x = GetReviewRequest{}
+	mi := &file_reviewer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewRequest) ProtoMessage() {}
+
+func (x *GetReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewRequest.ProtoReflect.Descriptor instead.
+func (*GetReviewRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetReviewRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type GetReviewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PullRequests  []*PullRequestShort    `protobuf:"bytes,2,rep,name=pull_requests,json=pullRequests,proto3" json:"pull_requests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReviewResponse) Reset() {
+	*x = GetReviewResponse{}
+	mi := &file_reviewer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReviewResponse) ProtoMessage() {}
+
+func (x *GetReviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReviewResponse.ProtoReflect.Descriptor instead.
+func (*GetReviewResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetReviewResponse) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *GetReviewResponse) GetPullRequests() []*PullRequestShort {
+	if x != nil {
+		return x.PullRequests
+	}
+	return nil
+}
+
+type CreatePullRequestRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId   string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	PullRequestName string                 `protobuf:"bytes,2,opt,name=pull_request_name,json=pullRequestName,proto3" json:"pull_request_name,omitempty"`
+	AuthorId        string                 `protobuf:"bytes,3,opt,name=author_id,json=authorId,proto3" json:"author_id,omitempty"`
+	// Число ревьюверов в пределах настроек команды; если не задано, назначается max_reviewers
+	ReviewerCount *int32 `protobuf:"varint,4,opt,name=reviewer_count,json=reviewerCount,proto3,oneof" json:"reviewer_count,omitempty"`
+	// Изменённые файлы; их владельцы из правил code owners назначаются первыми
+	ChangedFiles  []string `protobuf:"bytes,5,rep,name=changed_files,json=changedFiles,proto3" json:"changed_files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePullRequestRequest) Reset() {
+	*x = CreatePullRequestRequest{}
+	mi := &file_reviewer_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePullRequestRequest) ProtoMessage() {}
+
+func (x *CreatePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*CreatePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetPullRequestName() string {
+	if x != nil {
+		return x.PullRequestName
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetAuthorId() string {
+	if x != nil {
+		return x.AuthorId
+	}
+	return ""
+}
+
+func (x *CreatePullRequestRequest) GetReviewerCount() int32 {
+	if x != nil && x.ReviewerCount != nil {
+		return *x.ReviewerCount
+	}
+	return 0
+}
+
+func (x *CreatePullRequestRequest) GetChangedFiles() []string {
+	if x != nil {
+		return x.ChangedFiles
+	}
+	return nil
+}
+
+type MergePullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	// Слить в обход политики слияния команды
+	Force         bool `protobuf:"varint,2,opt,name=force,proto3" json:"force,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MergePullRequestRequest) Reset() {
+	*x = MergePullRequestRequest{}
+	mi := &file_reviewer_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MergePullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergePullRequestRequest) ProtoMessage() {}
+
+func (x *MergePullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergePullRequestRequest.ProtoReflect.Descriptor instead.
+func (*MergePullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MergePullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *MergePullRequestRequest) GetForce() bool {
+	if x != nil {
+		return x.Force
+	}
+	return false
+}
+
+type ReassignPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	OldUserId     string                 `protobuf:"bytes,2,opt,name=old_user_id,json=oldUserId,proto3" json:"old_user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignPullRequestRequest) Reset() {
+	*x = ReassignPullRequestRequest{}
+	mi := &file_reviewer_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignPullRequestRequest) ProtoMessage() {}
+
+func (x *ReassignPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*ReassignPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ReassignPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *ReassignPullRequestRequest) GetOldUserId() string {
+	if x != nil {
+		return x.OldUserId
+	}
+	return ""
+}
+
+type ReassignPullRequestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Pr            *PullRequest           `protobuf:"bytes,1,opt,name=pr,proto3" json:"pr,omitempty"`
+	ReplacedBy    string                 `protobuf:"bytes,2,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReassignPullRequestResponse) Reset() {
+	*x = ReassignPullRequestResponse{}
+	mi := &file_reviewer_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReassignPullRequestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReassignPullRequestResponse) ProtoMessage() {}
+
+func (x *ReassignPullRequestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReassignPullRequestResponse.ProtoReflect.Descriptor instead.
+func (*ReassignPullRequestResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ReassignPullRequestResponse) GetPr() *PullRequest {
+	if x != nil {
+		return x.Pr
+	}
+	return nil
+}
+
+func (x *ReassignPullRequestResponse) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+type GetPullRequestRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPullRequestRequest) Reset() {
+	*x = GetPullRequestRequest{}
+	mi := &file_reviewer_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPullRequestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPullRequestRequest) ProtoMessage() {}
+
+func (x *GetPullRequestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPullRequestRequest.ProtoReflect.Descriptor instead.
+func (*GetPullRequestRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetPullRequestRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+type SubmitReviewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PullRequestId string                 `protobuf:"bytes,1,opt,name=pull_request_id,json=pullRequestId,proto3" json:"pull_request_id,omitempty"`
+	ReviewerId    string                 `protobuf:"bytes,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	// APPROVED, CHANGES_REQUESTED или COMMENTED
+	State         string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Body          string `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitReviewRequest) Reset() {
+	*x = SubmitReviewRequest{}
+	mi := &file_reviewer_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitReviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitReviewRequest) ProtoMessage() {}
+
+func (x *SubmitReviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitReviewRequest.ProtoReflect.Descriptor instead.
+func (*SubmitReviewRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SubmitReviewRequest) GetPullRequestId() string {
+	if x != nil {
+		return x.PullRequestId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetReviewerId() string {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *SubmitReviewRequest) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+type GetAssignmentStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentStatsRequest) Reset() {
+	*x = GetAssignmentStatsRequest{}
+	mi := &file_reviewer_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentStatsRequest) ProtoMessage() {}
+
+func (x *GetAssignmentStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentStatsRequest) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{30}
+}
+
+type GetAssignmentStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Stats         []*AssignmentStat      `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentStatsResponse) Reset() {
+	*x = GetAssignmentStatsResponse{}
+	mi := &file_reviewer_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentStatsResponse) ProtoMessage() {}
+
+func (x *GetAssignmentStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_reviewer_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetAssignmentStatsResponse) Descriptor() ([]byte, []int) {
+	return file_reviewer_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAssignmentStatsResponse) GetStats() []*AssignmentStat {
+	if x != nil {
+		return x.Stats
+	}
+	return nil
+}
+
+var File_reviewer_proto protoreflect.FileDescriptor
+
+const file_reviewer_proto_rawDesc = "" +
+	"\n" +
+	"\x0ereviewer.proto\x12\vreviewer.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"^\n" +
+	"\n" +
+	"TeamMember\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tis_active\x18\x03 \x01(\bR\bisActive\"\xdd\x01\n" +
+	"\x04User\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1b\n" +
+	"\tteam_name\x18\x03 \x01(\tR\bteamName\x12\x1b\n" +
+	"\tis_active\x18\x04 \x01(\bR\bisActive\x12#\n" +
+	"\rreview_weight\x18\x05 \x01(\x05R\freviewWeight\x12\x17\n" +
+	"\ais_lead\x18\x06 \x01(\bR\x06isLead\x12(\n" +
+	"\x10max_open_reviews\x18\a \x01(\x05R\x0emaxOpenReviews\"V\n" +
+	"\x04Team\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x121\n" +
+	"\amembers\x18\x02 \x03(\v2\x17.reviewer.v1.TeamMemberR\amembers\"\x99\x04\n" +
+	"\vPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12-\n" +
+	"\x12assigned_reviewers\x18\x05 \x03(\tR\x11assignedReviewers\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x127\n" +
+	"\tmerged_at\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\bmergedAt\x12!\n" +
+	"\fforce_merged\x18\b \x01(\bR\vforceMerged\x12%\n" +
+	"\x0eunder_reviewed\x18\t \x01(\bR\runderReviewed\x12R\n" +
+	"\x0ereviewer_teams\x18\n" +
+	" \x03(\v2+.reviewer.v1.PullRequest.ReviewerTeamsEntryR\rreviewerTeams\x1a@\n" +
+	"\x12ReviewerTeamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x9b\x01\n" +
+	"\x10PullRequestShort\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\"}\n" +
+	"\rReviewerState\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12=\n" +
+	"\fsubmitted_at\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"\x92\x01\n" +
+	"\x06Review\x12\x1f\n" +
+	"\vreviewer_id\x18\x01 \x01(\tR\n" +
+	"reviewerId\x12\x14\n" +
+	"\x05state\x18\x02 \x01(\tR\x05state\x12\x12\n" +
+	"\x04body\x18\x03 \x01(\tR\x04body\x12=\n" +
+	"\fsubmitted_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\vsubmittedAt\"\xa6\x01\n" +
+	"\x11PullRequestDetail\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\x128\n" +
+	"\treviewers\x18\x02 \x03(\v2\x1a.reviewer.v1.ReviewerStateR\treviewers\x12-\n" +
+	"\areviews\x18\x03 \x03(\v2\x13.reviewer.v1.ReviewR\areviews\"?\n" +
+	"\x0eAssignmentStat\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x05R\x05count\"7\n" +
+	"\x0eAddTeamRequest\x12%\n" +
+	"\x04team\x18\x01 \x01(\v2\x11.reviewer.v1.TeamR\x04team\")\n" +
+	"\x0fAddTeamResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\"-\n" +
+	"\x0eGetTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\"M\n" +
+	"\x15DeactivateTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"i\n" +
+	"\x16DeactivateTeamResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x127\n" +
+	"\x06report\x18\x02 \x01(\v2\x1f.reviewer.v1.DeactivationReportR\x06report\"\x9e\x01\n" +
+	"\fReassignment\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12&\n" +
+	"\x0fold_reviewer_id\x18\x02 \x01(\tR\roldReviewerId\x12&\n" +
+	"\x0fnew_reviewer_id\x18\x03 \x01(\tR\rnewReviewerId\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\"\x92\x01\n" +
+	"\x18UnderReviewedPullRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12)\n" +
+	"\x10active_reviewers\x18\x02 \x03(\tR\x0factiveReviewers\x12#\n" +
+	"\rmin_reviewers\x18\x03 \x01(\x05R\fminReviewers\"\xc2\x02\n" +
+	"\x12DeactivationReport\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12+\n" +
+	"\x11deactivated_users\x18\x03 \x03(\tR\x10deactivatedUsers\x12?\n" +
+	"\rreassignments\x18\x04 \x03(\v2\x19.reviewer.v1.ReassignmentR\rreassignments\x12L\n" +
+	"\x0eunder_reviewed\x18\x05 \x03(\v2%.reviewer.v1.UnderReviewedPullRequestR\runderReviewed\x12:\n" +
+	"\trebalance\x18\x06 \x01(\v2\x1c.reviewer.v1.RebalanceReportR\trebalance\"S\n" +
+	"\n" +
+	"MemberLoad\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x16\n" +
+	"\x06before\x18\x02 \x01(\x05R\x06before\x12\x14\n" +
+	"\x05after\x18\x03 \x01(\x05R\x05after\"L\n" +
+	"\x14RebalanceTeamRequest\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\"\xa7\x01\n" +
+	"\x0fRebalanceReport\x12\x1b\n" +
+	"\tteam_name\x18\x01 \x01(\tR\bteamName\x12\x17\n" +
+	"\adry_run\x18\x02 \x01(\bR\x06dryRun\x12/\n" +
+	"\x05moves\x18\x03 \x03(\v2\x19.reviewer.v1.ReassignmentR\x05moves\x12-\n" +
+	"\x05loads\x18\x04 \x03(\v2\x17.reviewer.v1.MemberLoadR\x05loads\"\x81\x01\n" +
+	"\x12SetIsActiveRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12\x1b\n" +
+	"\tis_active\x18\x02 \x01(\bR\bisActive\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x1c\n" +
+	"\trebalance\x18\x04 \x01(\bR\trebalance\"\x83\x01\n" +
+	"\x13SetIsActiveResponse\x127\n" +
+	"\x06report\x18\x02 \x01(\v2\x1f.reviewer.v1.DeactivationReportR\x06report\x12%\n" +
+	"\x04user\x18\x03 \x01(\v2\x11.reviewer.v1.UserR\x04userJ\x04\b\x01\x10\x02R\x06status\"+\n" +
+	"\x10GetReviewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"p\n" +
+	"\x11GetReviewResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\x12B\n" +
+	"\rpull_requests\x18\x02 \x03(\v2\x1d.reviewer.v1.PullRequestShortR\fpullRequests\"\xef\x01\n" +
+	"\x18CreatePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12*\n" +
+	"\x11pull_request_name\x18\x02 \x01(\tR\x0fpullRequestName\x12\x1b\n" +
+	"\tauthor_id\x18\x03 \x01(\tR\bauthorId\x12*\n" +
+	"\x0ereviewer_count\x18\x04 \x01(\x05H\x00R\rreviewerCount\x88\x01\x01\x12#\n" +
+	"\rchanged_files\x18\x05 \x03(\tR\fchangedFilesB\x11\n" +
+	"\x0f_reviewer_count\"W\n" +
+	"\x17MergePullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x14\n" +
+	"\x05force\x18\x02 \x01(\bR\x05force\"d\n" +
+	"\x1aReassignPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1e\n" +
+	"\vold_user_id\x18\x02 \x01(\tR\toldUserId\"h\n" +
+	"\x1bReassignPullRequestResponse\x12(\n" +
+	"\x02pr\x18\x01 \x01(\v2\x18.reviewer.v1.PullRequestR\x02pr\x12\x1f\n" +
+	"\vreplaced_by\x18\x02 \x01(\tR\n" +
+	"replacedBy\"?\n" +
+	"\x15GetPullRequestRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\"\x88\x01\n" +
+	"\x13SubmitReviewRequest\x12&\n" +
+	"\x0fpull_request_id\x18\x01 \x01(\tR\rpullRequestId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\tR\n" +
+	"reviewerId\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\x12\x12\n" +
+	"\x04body\x18\x04 \x01(\tR\x04body\"\x1b\n" +
+	"\x19GetAssignmentStatsRequest\"O\n" +
+	"\x1aGetAssignmentStatsResponse\x121\n" +
+	"\x05stats\x18\x01 \x03(\v2\x1b.reviewer.v1.AssignmentStatR\x05stats2\x80\b\n" +
+	"\x0fReviewerService\x12D\n" +
+	"\aAddTeam\x12\x1b.reviewer.v1.AddTeamRequest\x1a\x1c.reviewer.v1.AddTeamResponse\x129\n" +
+	"\aGetTeam\x12\x1b.reviewer.v1.GetTeamRequest\x1a\x11.reviewer.v1.Team\x12Y\n" +
+	"\x0eDeactivateTeam\x12\".reviewer.v1.DeactivateTeamRequest\x1a#.reviewer.v1.DeactivateTeamResponse\x12P\n" +
+	"\rRebalanceTeam\x12!.reviewer.v1.RebalanceTeamRequest\x1a\x1c.reviewer.v1.RebalanceReport\x12P\n" +
+	"\vSetIsActive\x12\x1f.reviewer.v1.SetIsActiveRequest\x1a .reviewer.v1.SetIsActiveResponse\x12J\n" +
+	"\tGetReview\x12\x1d.reviewer.v1.GetReviewRequest\x1a\x1e.reviewer.v1.GetReviewResponse\x12T\n" +
+	"\x11CreatePullRequest\x12%.reviewer.v1.CreatePullRequestRequest\x1a\x18.reviewer.v1.PullRequest\x12R\n" +
+	"\x10MergePullRequest\x12$.reviewer.v1.MergePullRequestRequest\x1a\x18.reviewer.v1.PullRequest\x12h\n" +
+	"\x13ReassignPullRequest\x12'.reviewer.v1.ReassignPullRequestRequest\x1a(.reviewer.v1.ReassignPullRequestResponse\x12T\n" +
+	"\x0eGetPullRequest\x12\".reviewer.v1.GetPullRequestRequest\x1a\x1e.reviewer.v1.PullRequestDetail\x12P\n" +
+	"\fSubmitReview\x12 .reviewer.v1.SubmitReviewRequest\x1a\x1e.reviewer.v1.PullRequestDetail\x12e\n" +
+	"\x12GetAssignmentStats\x12&.reviewer.v1.GetAssignmentStatsRequest\x1a'.reviewer.v1.GetAssignmentStatsResponseB,Z*avito-internship/api/reviewerpb;reviewerpbb\x06proto3"
+
+var (
+	file_reviewer_proto_rawDescOnce sync.Once
+	file_reviewer_proto_rawDescData []byte
+)
+
+func file_reviewer_proto_rawDescGZIP() []byte {
+	file_reviewer_proto_rawDescOnce.Do(func() {
+		file_reviewer_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_reviewer_proto_rawDesc), len(file_reviewer_proto_rawDesc)))
+	})
+	return file_reviewer_proto_rawDescData
+}
+
+var file_reviewer_proto_msgTypes = make([]protoimpl.MessageInfo, 33)
+var file_reviewer_proto_goTypes = []any{
+	(*TeamMember)(nil),                  // 0: reviewer.v1.TeamMember
+	(*User)(nil),                        // 1: reviewer.v1.User
+	(*Team)(nil),                        // 2: reviewer.v1.Team
+	(*PullRequest)(nil),                 // 3: reviewer.v1.PullRequest
+	(*PullRequestShort)(nil),            // 4: reviewer.v1.PullRequestShort
+	(*ReviewerState)(nil),               // 5: reviewer.v1.ReviewerState
+	(*Review)(nil),                      // 6: reviewer.v1.Review
+	(*PullRequestDetail)(nil),           // 7: reviewer.v1.PullRequestDetail
+	(*AssignmentStat)(nil),              // 8: reviewer.v1.AssignmentStat
+	(*AddTeamRequest)(nil),              // 9: reviewer.v1.AddTeamRequest
+	(*AddTeamResponse)(nil),             // 10: reviewer.v1.AddTeamResponse
+	(*GetTeamRequest)(nil),              // 11: reviewer.v1.GetTeamRequest
+	(*DeactivateTeamRequest)(nil),       // 12: reviewer.v1.DeactivateTeamRequest
+	(*DeactivateTeamResponse)(nil),      // 13: reviewer.v1.DeactivateTeamResponse
+	(*Reassignment)(nil),                // 14: reviewer.v1.Reassignment
+	(*UnderReviewedPullRequest)(nil),    // 15: reviewer.v1.UnderReviewedPullRequest
+	(*DeactivationReport)(nil),          // 16: reviewer.v1.DeactivationReport
+	(*MemberLoad)(nil),                  // 17: reviewer.v1.MemberLoad
+	(*RebalanceTeamRequest)(nil),        // 18: reviewer.v1.RebalanceTeamRequest
+	(*RebalanceReport)(nil),             // 19: reviewer.v1.RebalanceReport
+	(*SetIsActiveRequest)(nil),          // 20: reviewer.v1.SetIsActiveRequest
+	(*SetIsActiveResponse)(nil),         // 21: reviewer.v1.SetIsActiveResponse
+	(*GetReviewRequest)(nil),            // 22: reviewer.v1.GetReviewRequest
+	(*GetReviewResponse)(nil),           // 23: reviewer.v1.GetReviewResponse
+	(*CreatePullRequestRequest)(nil),    // 24: reviewer.v1.CreatePullRequestRequest
+	(*MergePullRequestRequest)(nil),     // 25: reviewer.v1.MergePullRequestRequest
+	(*ReassignPullRequestRequest)(nil),  // 26: reviewer.v1.ReassignPullRequestRequest
+	(*ReassignPullRequestResponse)(nil), // 27: reviewer.v1.ReassignPullRequestResponse
+	(*GetPullRequestRequest)(nil),       // 28: reviewer.v1.GetPullRequestRequest
+	(*SubmitReviewRequest)(nil),         // 29: reviewer.v1.SubmitReviewRequest
+	(*GetAssignmentStatsRequest)(nil),   // 30: reviewer.v1.GetAssignmentStatsRequest
+	(*GetAssignmentStatsResponse)(nil),  // 31: reviewer.v1.GetAssignmentStatsResponse
+	nil,                                 // 32: reviewer.v1.PullRequest.ReviewerTeamsEntry
+	(*timestamppb.Timestamp)(nil),       // 33: google.protobuf.Timestamp
+}
+var file_reviewer_proto_depIdxs = []int32{
+	0,  // 0: reviewer.v1.Team.members:type_name -> reviewer.v1.TeamMember
+	33, // 1: reviewer.v1.PullRequest.created_at:type_name -> google.protobuf.Timestamp
+	33, // 2: reviewer.v1.PullRequest.merged_at:type_name -> google.protobuf.Timestamp
+	32, // 3: reviewer.v1.PullRequest.reviewer_teams:type_name -> reviewer.v1.PullRequest.ReviewerTeamsEntry
+	33, // 4: reviewer.v1.ReviewerState.submitted_at:type_name -> google.protobuf.Timestamp
+	33, // 5: reviewer.v1.Review.submitted_at:type_name -> google.protobuf.Timestamp
+	3,  // 6: reviewer.v1.PullRequestDetail.pr:type_name -> reviewer.v1.PullRequest
+	5,  // 7: reviewer.v1.PullRequestDetail.reviewers:type_name -> reviewer.v1.ReviewerState
+	6,  // 8: reviewer.v1.PullRequestDetail.reviews:type_name -> reviewer.v1.Review
+	2,  // 9: reviewer.v1.AddTeamRequest.team:type_name -> reviewer.v1.Team
+	16, // 10: reviewer.v1.DeactivateTeamResponse.report:type_name -> reviewer.v1.DeactivationReport
+	14, // 11: reviewer.v1.DeactivationReport.reassignments:type_name -> reviewer.v1.Reassignment
+	15, // 12: reviewer.v1.DeactivationReport.under_reviewed:type_name -> reviewer.v1.UnderReviewedPullRequest
+	19, // 13: reviewer.v1.DeactivationReport.rebalance:type_name -> reviewer.v1.RebalanceReport
+	14, // 14: reviewer.v1.RebalanceReport.moves:type_name -> reviewer.v1.Reassignment
+	17, // 15: reviewer.v1.RebalanceReport.loads:type_name -> reviewer.v1.MemberLoad
+	16, // 16: reviewer.v1.SetIsActiveResponse.report:type_name -> reviewer.v1.DeactivationReport
+	1,  // 17: reviewer.v1.SetIsActiveResponse.user:type_name -> reviewer.v1.User
+	4,  // 18: reviewer.v1.GetReviewResponse.pull_requests:type_name -> reviewer.v1.PullRequestShort
+	3,  // 19: reviewer.v1.ReassignPullRequestResponse.pr:type_name -> reviewer.v1.PullRequest
+	8,  // 20: reviewer.v1.GetAssignmentStatsResponse.stats:type_name -> reviewer.v1.AssignmentStat
+	9,  // 21: reviewer.v1.ReviewerService.AddTeam:input_type -> reviewer.v1.AddTeamRequest
+	11, // 22: reviewer.v1.ReviewerService.GetTeam:input_type -> reviewer.v1.GetTeamRequest
+	12, // 23: reviewer.v1.ReviewerService.DeactivateTeam:input_type -> reviewer.v1.DeactivateTeamRequest
+	18, // 24: reviewer.v1.ReviewerService.RebalanceTeam:input_type -> reviewer.v1.RebalanceTeamRequest
+	20, // 25: reviewer.v1.ReviewerService.SetIsActive:input_type -> reviewer.v1.SetIsActiveRequest
+	22, // 26: reviewer.v1.ReviewerService.GetReview:input_type -> reviewer.v1.GetReviewRequest
+	24, // 27: reviewer.v1.ReviewerService.CreatePullRequest:input_type -> reviewer.v1.CreatePullRequestRequest
+	25, // 28: reviewer.v1.ReviewerService.MergePullRequest:input_type -> reviewer.v1.MergePullRequestRequest
+	26, // 29: reviewer.v1.ReviewerService.ReassignPullRequest:input_type -> reviewer.v1.ReassignPullRequestRequest
+	28, // 30: reviewer.v1.ReviewerService.GetPullRequest:input_type -> reviewer.v1.GetPullRequestRequest
+	29, // 31: reviewer.v1.ReviewerService.SubmitReview:input_type -> reviewer.v1.SubmitReviewRequest
+	30, // 32: reviewer.v1.ReviewerService.GetAssignmentStats:input_type -> reviewer.v1.GetAssignmentStatsRequest
+	10, // 33: reviewer.v1.ReviewerService.AddTeam:output_type -> reviewer.v1.AddTeamResponse
+	2,  // 34: reviewer.v1.ReviewerService.GetTeam:output_type -> reviewer.v1.Team
+	13, // 35: reviewer.v1.ReviewerService.DeactivateTeam:output_type -> reviewer.v1.DeactivateTeamResponse
+	19, // 36: reviewer.v1.ReviewerService.RebalanceTeam:output_type -> reviewer.v1.RebalanceReport
+	21, // 37: reviewer.v1.ReviewerService.SetIsActive:output_type -> reviewer.v1.SetIsActiveResponse
+	23, // 38: reviewer.v1.ReviewerService.GetReview:output_type -> reviewer.v1.GetReviewResponse
+	3,  // 39: reviewer.v1.ReviewerService.CreatePullRequest:output_type -> reviewer.v1.PullRequest
+	3,  // 40: reviewer.v1.ReviewerService.MergePullRequest:output_type -> reviewer.v1.PullRequest
+	27, // 41: reviewer.v1.ReviewerService.ReassignPullRequest:output_type -> reviewer.v1.ReassignPullRequestResponse
+	7,  // 42: reviewer.v1.ReviewerService.GetPullRequest:output_type -> reviewer.v1.PullRequestDetail
+	7,  // 43: reviewer.v1.ReviewerService.SubmitReview:output_type -> reviewer.v1.PullRequestDetail
+	31, // 44: reviewer.v1.ReviewerService.GetAssignmentStats:output_type -> reviewer.v1.GetAssignmentStatsResponse
+	33, // [33:45] is the sub-list for method output_type
+	21, // [21:33] is the sub-list for method input_type
+	21, // [21:21] is the sub-list for extension type_name
+	21, // [21:21] is the sub-list for extension extendee
+	0,  // [0:21] is the sub-list for field type_name
+}
+
+func init() { file_reviewer_proto_init() }
+func file_reviewer_proto_init() {
+	if File_reviewer_proto != nil {
+		return
+	}
+	file_reviewer_proto_msgTypes[24].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_reviewer_proto_rawDesc), len(file_reviewer_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   33,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_reviewer_proto_goTypes,
+		DependencyIndexes: file_reviewer_proto_depIdxs,
+		MessageInfos:      file_reviewer_proto_msgTypes,
+	}.Build()
+	File_reviewer_proto = out.File
+	file_reviewer_proto_goTypes = nil
+	file_reviewer_proto_depIdxs = nil
+}
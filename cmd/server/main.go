@@ -1,16 +1,28 @@
 package main
 
 import (
+	"context"
 	"fmt"
+	"net"
+	"net/http"
+	"time"
 
 	"avito-internship/api"
+	"avito-internship/api/reviewerpb"
 	"avito-internship/internal/config"
 	"avito-internship/internal/handler"
+	"avito-internship/internal/health"
+	"avito-internship/internal/metrics"
+	"avito-internship/internal/outbox"
+	"avito-internship/internal/repository"
 	"avito-internship/internal/service"
 	"avito-internship/internal/storage"
+	"avito-internship/internal/worker"
 	"avito-internship/migrations"
 
 	"github.com/labstack/echo/v4"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
 )
 
 func main() {
@@ -20,20 +32,102 @@ func main() {
 	}
 
 	e := echo.New()
+	e.HTTPErrorHandler = handler.ErrorHandler
 
-	connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
-		cfg.DBHost, cfg.DBPort, cfg.DBUser, cfg.DBPassword, cfg.DBName)
-	storage, err := storage.NewStorage(connStr)
+	repo, err := newRepository(cfg)
 	if err != nil {
 		panic(err)
 	}
-	if err := migrations.RunMigrations(storage.DB); err != nil {
+	strategy, err := service.StrategyByName(cfg.ReviewerStrategy)
+	if err != nil {
 		panic(err)
 	}
-	service := service.NewService(storage)
-	handlers := handler.NewHandlers(service)
+	appMetrics := metrics.New(repo)
+	service := service.NewService(repo, service.WithDefaultStrategy(strategy), service.WithMetrics(appMetrics),
+		service.WithOutboxSinks(newOutboxSinks(cfg, e.Logger)...))
+	readinessChecks := []health.Check{health.Config(cfg)}
+	if pg, ok := repo.(*storage.Storage); ok {
+		checker, err := migrations.NewChecker(pg.DB)
+		if err != nil {
+			panic(err)
+		}
+		readinessChecks = append(readinessChecks, health.Database(pg.DB), health.Migrations(checker))
+		appMetrics.RegisterDB(pg.DB, cfg.DBName)
+	}
+	handlers := handler.NewHandlers(service, readinessChecks...).WithForgeSecrets(handler.ForgeSecrets{
+		GitHub: cfg.GitHubWebhookSecret,
+		GitLab: cfg.GitLabWebhookToken,
+	})
 
+	e.Use(appMetrics.Middleware())
+	e.GET("/metrics", echo.WrapHandler(appMetrics.Handler()))
 	api.RegisterHandlers(e, handlers)
 
+	grpcServer := grpc.NewServer()
+	reviewerpb.RegisterReviewerServiceServer(grpcServer, handler.NewGRPCServer(service))
+	reflection.Register(grpcServer)
+	lis, err := net.Listen("tcp", ":"+cfg.GRPCPort)
+	if err != nil {
+		panic(err)
+	}
+	go func() {
+		if err := grpcServer.Serve(lis); err != nil {
+			e.Logger.Fatal(err)
+		}
+	}()
+
+	go worker.Run(context.Background(), "absences", cfg.AbsenceCheckInterval, e.Logger, func(context.Context) error {
+		_, err := service.ProcessAbsences()
+		return err
+	})
+	go worker.Run(context.Background(), "review_sla", cfg.SLACheckInterval, e.Logger, func(context.Context) error {
+		_, _, err := service.ProcessReviewSLA()
+		return err
+	})
+	go worker.Run(context.Background(), "webhooks", cfg.WebhookInterval, e.Logger, func(ctx context.Context) error {
+		_, err := service.ProcessWebhooks(ctx)
+		return err
+	})
+
+	go worker.Run(context.Background(), "outbox", cfg.OutboxInterval, e.Logger, func(ctx context.Context) error {
+		_, err := service.ProcessOutbox(ctx)
+		return err
+	})
+
 	e.Logger.Fatal(e.Start(":" + cfg.HTTPPort))
 }
+
+func newOutboxSinks(cfg *config.Config, logger outbox.Logger) []outbox.Sink {
+	var sinks []outbox.Sink
+	for _, name := range cfg.OutboxSinks {
+		switch name {
+		case outbox.SinkLog:
+			sinks = append(sinks, outbox.NewLogSink(logger))
+		case outbox.SinkHTTP:
+			sinks = append(sinks, outbox.NewHTTPSink(cfg.OutboxHTTPURL, &http.Client{Timeout: 10 * time.Second}))
+		case outbox.SinkFile:
+			sinks = append(sinks, outbox.NewFileSink(cfg.OutboxFilePath))
+		}
+	}
+	return sinks
+}
+
+func newRepository(cfg *config.Config) (repository.Repository, error) {
+	switch cfg.StorageType {
+	case "inmem":
+		return storage.NewInMemStorage(), nil
+	case "postgres":
+		connStr := fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable",
+			cfg.DBHost, cfg.DBPort, cfg.DBUser, cfg.DBPassword, cfg.DBName)
+		pg, err := storage.NewStorage(connStr)
+		if err != nil {
+			return nil, err
+		}
+		if err := migrations.RunMigrations(pg.DB); err != nil {
+			return nil, err
+		}
+		return pg, nil
+	default:
+		return nil, fmt.Errorf("unknown storage type %q", cfg.StorageType)
+	}
+}
@@ -1,32 +1,126 @@
 package config
 
 import (
+	"errors"
+	"fmt"
 	"os"
 	"strings"
+	"time"
 )
 
 type Config struct {
-	HTTPPort    string
-	GRPCPort    string
-	StorageType string
-	DBHost      string
-	DBPort      string
-	DBUser      string
-	DBPassword  string
-	DBName      string
+	HTTPPort         string
+	GRPCPort         string
+	StorageType      string
+	ReviewerStrategy string
+	DBHost           string
+	DBPort           string
+	DBUser           string
+	DBPassword       string
+	DBName           string
+	// AbsenceCheckInterval - как часто переназначаются ревью пользователей, у которых началось отсутствие
+	AbsenceCheckInterval time.Duration
+	// SLACheckInterval - как часто отмечаются просроченные ревью и заменяются молчащие ревьюверы
+	SLACheckInterval time.Duration
+	// WebhookInterval - как часто отправляются ожидающие доставки вебхуков
+	WebhookInterval time.Duration
+	// OutboxSinks - получатели доменных событий из outbox: log, http, file
+	OutboxSinks    []string
+	OutboxInterval time.Duration
+	OutboxHTTPURL  string
+	OutboxFilePath string
+	// Секреты входящих вебхуков GitHub и GitLab; пустой секрет отключает приём событий этого forge
+	GitHubWebhookSecret string
+	GitLabWebhookToken  string
 }
 
 func Load() (*Config, error) {
-	return &Config{
-		HTTPPort:    getEnv("HTTP_PORT", "8080"),
-		GRPCPort:    getEnv("GRPC_PORT", "50051"),
-		StorageType: strings.ToLower(getEnv("STORAGE_TYPE", "inmem")),
-		DBHost:      getEnv("DB_HOST", "localhost"),
-		DBPort:      getEnv("DB_PORT", "5432"),
-		DBUser:      getEnv("DB_USER", "postgres"),
-		DBPassword:  getEnv("DB_PASSWORD", "postgres"),
-		DBName:      getEnv("DB_NAME", "links"),
-	}, nil
+	cfg := &Config{
+		HTTPPort:            getEnv("HTTP_PORT", "8080"),
+		GRPCPort:            getEnv("GRPC_PORT", "50051"),
+		StorageType:         strings.ToLower(getEnv("STORAGE_TYPE", "inmem")),
+		ReviewerStrategy:    strings.ToLower(getEnv("REVIEWER_STRATEGY", "random")),
+		DBHost:              getEnv("DB_HOST", "localhost"),
+		DBPort:              getEnv("DB_PORT", "5432"),
+		DBUser:              getEnv("DB_USER", "postgres"),
+		DBPassword:          getEnv("DB_PASSWORD", "postgres"),
+		DBName:              getEnv("DB_NAME", "links"),
+		GitHubWebhookSecret: getEnv("GITHUB_WEBHOOK_SECRET", ""),
+		GitLabWebhookToken:  getEnv("GITLAB_WEBHOOK_TOKEN", ""),
+		OutboxHTTPURL:       getEnv("OUTBOX_HTTP_URL", ""),
+		OutboxFilePath:      getEnv("OUTBOX_FILE_PATH", ""),
+	}
+	for _, sink := range strings.Split(getEnv("OUTBOX_SINKS", "log"), ",") {
+		if sink = strings.ToLower(strings.TrimSpace(sink)); sink != "" {
+			cfg.OutboxSinks = append(cfg.OutboxSinks, sink)
+		}
+	}
+	interval, err := time.ParseDuration(getEnv("ABSENCE_CHECK_INTERVAL", "1m"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid ABSENCE_CHECK_INTERVAL: %w", err)
+	}
+	cfg.AbsenceCheckInterval = interval
+	if cfg.SLACheckInterval, err = time.ParseDuration(getEnv("SLA_CHECK_INTERVAL", "5m")); err != nil {
+		return nil, fmt.Errorf("invalid SLA_CHECK_INTERVAL: %w", err)
+	}
+	if cfg.WebhookInterval, err = time.ParseDuration(getEnv("WEBHOOK_DELIVERY_INTERVAL", "5s")); err != nil {
+		return nil, fmt.Errorf("invalid WEBHOOK_DELIVERY_INTERVAL: %w", err)
+	}
+	if cfg.OutboxInterval, err = time.ParseDuration(getEnv("OUTBOX_INTERVAL", "1s")); err != nil {
+		return nil, fmt.Errorf("invalid OUTBOX_INTERVAL: %w", err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+func (c *Config) Validate() error {
+	if c.HTTPPort == "" || c.GRPCPort == "" {
+		return errors.New("HTTP_PORT and GRPC_PORT must not be empty")
+	}
+	switch c.StorageType {
+	case "inmem":
+	case "postgres":
+		if c.DBHost == "" || c.DBName == "" {
+			return errors.New("DB_HOST and DB_NAME must be set for postgres storage")
+		}
+	default:
+		return fmt.Errorf("unknown storage type %q", c.StorageType)
+	}
+	if c.AbsenceCheckInterval <= 0 {
+		return errors.New("ABSENCE_CHECK_INTERVAL must be positive")
+	}
+	if c.SLACheckInterval <= 0 {
+		return errors.New("SLA_CHECK_INTERVAL must be positive")
+	}
+	if c.WebhookInterval <= 0 {
+		return errors.New("WEBHOOK_DELIVERY_INTERVAL must be positive")
+	}
+	if c.OutboxInterval <= 0 {
+		return errors.New("OUTBOX_INTERVAL must be positive")
+	}
+	for i, sink := range c.OutboxSinks {
+		for _, prev := range c.OutboxSinks[:i] {
+			if prev == sink {
+				return fmt.Errorf("outbox sink %q is listed twice", sink)
+			}
+		}
+		switch sink {
+		case "log":
+		case "http":
+			if c.OutboxHTTPURL == "" {
+				return errors.New("OUTBOX_HTTP_URL must be set for the http outbox sink")
+			}
+		case "file":
+			if c.OutboxFilePath == "" {
+				return errors.New("OUTBOX_FILE_PATH must be set for the file outbox sink")
+			}
+		default:
+			return fmt.Errorf("unknown outbox sink %q", sink)
+		}
+	}
+	return nil
 }
 
 func getEnv(key, defaultValue string) string {
@@ -34,4 +128,4 @@ func getEnv(key, defaultValue string) string {
 		return value
 	}
 	return defaultValue
-}
\ No newline at end of file
+}
@@ -0,0 +1,58 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Категории доменных ошибок; конкретная ошибка оборачивает одну из них и несёт своё сообщение
+var (
+	ErrNotFound      = errors.New("not found")
+	ErrAlreadyExists = errors.New("already exists")
+	ErrTeamExists    = fmt.Errorf("team %w", ErrAlreadyExists)
+	ErrPRExists      = fmt.Errorf("pull request %w", ErrAlreadyExists)
+	ErrMerged        = errors.New("pull request is merged")
+	ErrNotAssigned   = errors.New("reviewer is not assigned")
+	ErrNoCandidate   = errors.New("no candidate")
+	ErrValidation    = errors.New("validation failed")
+	ErrConflict      = errors.New("conflict")
+	ErrMergeBlocked  = errors.New("merge blocked")
+	ErrUnauthorized  = errors.New("unauthorized")
+	ErrInternal      = errors.New("internal error")
+)
+
+type Error struct {
+	kind    error
+	message string
+	details []string
+}
+
+func New(kind error, message string) error {
+	return &Error{kind: kind, message: message}
+}
+
+func Newf(kind error, format string, args ...any) error {
+	return &Error{kind: kind, message: fmt.Sprintf(format, args...)}
+}
+
+// WithDetails добавляет к ошибке список причин, который отдаётся клиенту отдельно от сообщения
+func WithDetails(kind error, message string, details []string) error {
+	return &Error{kind: kind, message: message, details: details}
+}
+
+// Details возвращает причины ошибки, если они были заданы через WithDetails
+func Details(err error) []string {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.details
+	}
+	return nil
+}
+
+func (e *Error) Error() string {
+	return e.message
+}
+
+func (e *Error) Unwrap() error {
+	return e.kind
+}
@@ -0,0 +1,30 @@
+package errs
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestError(t *testing.T) {
+	err := New(ErrTeamExists, "team already exists")
+	assert.EqualError(t, err, "team already exists")
+	assert.ErrorIs(t, err, ErrTeamExists)
+	assert.ErrorIs(t, err, ErrAlreadyExists)
+	assert.NotErrorIs(t, err, ErrPRExists)
+
+	wrapped := fmt.Errorf("add team: %w", Newf(ErrNotFound, "user %s not found", "u1"))
+	assert.ErrorIs(t, wrapped, ErrNotFound)
+	assert.False(t, errors.Is(wrapped, ErrValidation))
+	assert.EqualError(t, wrapped, "add team: user u1 not found")
+}
+
+func TestDetails(t *testing.T) {
+	err := WithDetails(ErrMergeBlocked, "merge policy is not satisfied", []string{"changes requested by u2"})
+	assert.ErrorIs(t, err, ErrMergeBlocked)
+	assert.Equal(t, []string{"changes requested by u2"}, Details(fmt.Errorf("merge: %w", err)))
+	assert.Nil(t, Details(New(ErrNotFound, "PR not found")))
+	assert.Nil(t, Details(errors.New("plain")))
+}
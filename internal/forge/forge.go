@@ -0,0 +1,156 @@
+package forge
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+const (
+	GitHub = "github"
+	GitLab = "gitlab"
+)
+
+const (
+	ActionOpened = "opened"
+	ActionMerged = "merged"
+	ActionClosed = "closed"
+)
+
+// Event - изменение PR на стороне GitHub или GitLab, приведённое к общему виду
+type Event struct {
+	Forge  string
+	Action string
+	// PullRequestId строится из репозитория и номера PR, поэтому одинаков во всех событиях одного PR
+	PullRequestId string
+	Title         string
+	// AuthorLogin - имя автора на стороне forge, по нему ищется user_id; пусто, если событие его не содержит
+	AuthorLogin string
+	// AuthorAccountId - числовой id аккаунта автора; по нему ищется user_id, если AuthorLogin пуст
+	AuthorAccountId int64
+}
+
+// VerifyGitHub проверяет заголовок X-Hub-Signature-256 ("sha256=<hex HMAC-SHA256 тела>")
+func VerifyGitHub(secret string, body []byte, signature string) bool {
+	hexSum, ok := strings.CutPrefix(signature, "sha256=")
+	if !ok || secret == "" {
+		return false
+	}
+	sum, err := hex.DecodeString(hexSum)
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(sum, mac.Sum(nil))
+}
+
+// VerifyGitLab сравнивает заголовок X-Gitlab-Token с секретным токеном вебхука
+func VerifyGitLab(token, header string) bool {
+	return token != "" && subtle.ConstantTimeCompare([]byte(token), []byte(header)) == 1
+}
+
+type githubPayload struct {
+	Action      string `json:"action"`
+	PullRequest struct {
+		Number int    `json:"number"`
+		Title  string `json:"title"`
+		Merged bool   `json:"merged"`
+		User   struct {
+			Login string `json:"login"`
+		} `json:"user"`
+	} `json:"pull_request"`
+	Repository struct {
+		FullName string `json:"full_name"`
+	} `json:"repository"`
+}
+
+// ParseGitHub разбирает событие pull_request. ok == false, если событие или действие не влияет на PR в сервисе.
+func ParseGitHub(eventType string, body []byte) (ev Event, ok bool, err error) {
+	if eventType != "pull_request" {
+		return Event{}, false, nil
+	}
+	var p githubPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return Event{}, false, fmt.Errorf("invalid github payload: %w", err)
+	}
+	if p.Repository.FullName == "" || p.PullRequest.Number == 0 {
+		return Event{}, false, fmt.Errorf("github payload has no repository or pull request number")
+	}
+	ev = Event{
+		Forge:         GitHub,
+		PullRequestId: fmt.Sprintf("%s:%s#%d", GitHub, p.Repository.FullName, p.PullRequest.Number),
+		Title:         p.PullRequest.Title,
+		AuthorLogin:   p.PullRequest.User.Login,
+	}
+	switch {
+	case p.Action == "opened" || p.Action == "reopened":
+		ev.Action = ActionOpened
+	case p.Action == "closed" && p.PullRequest.Merged:
+		ev.Action = ActionMerged
+	case p.Action == "closed":
+		ev.Action = ActionClosed
+	default:
+		return Event{}, false, nil
+	}
+	return ev, true, nil
+}
+
+type gitlabPayload struct {
+	ObjectKind string `json:"object_kind"`
+	User       struct {
+		Id       int64  `json:"id"`
+		Username string `json:"username"`
+	} `json:"user"`
+	Project struct {
+		PathWithNamespace string `json:"path_with_namespace"`
+	} `json:"project"`
+	ObjectAttributes struct {
+		Iid      int    `json:"iid"`
+		Title    string `json:"title"`
+		Action   string `json:"action"`
+		AuthorId int64  `json:"author_id"`
+	} `json:"object_attributes"`
+}
+
+// ParseGitLab разбирает событие Merge Request Hook. В событии есть только id автора MR, а user - это тот,
+// кто вызвал событие, поэтому его username берётся как логин автора, только если это сам автор.
+func ParseGitLab(eventType string, body []byte) (ev Event, ok bool, err error) {
+	if eventType != "Merge Request Hook" {
+		return Event{}, false, nil
+	}
+	var p gitlabPayload
+	if err := json.Unmarshal(body, &p); err != nil {
+		return Event{}, false, fmt.Errorf("invalid gitlab payload: %w", err)
+	}
+	if p.ObjectKind != "merge_request" {
+		return Event{}, false, nil
+	}
+	if p.Project.PathWithNamespace == "" || p.ObjectAttributes.Iid == 0 {
+		return Event{}, false, fmt.Errorf("gitlab payload has no project or merge request iid")
+	}
+	ev = Event{
+		Forge:           GitLab,
+		PullRequestId:   fmt.Sprintf("%s:%s!%d", GitLab, p.Project.PathWithNamespace, p.ObjectAttributes.Iid),
+		Title:           p.ObjectAttributes.Title,
+		AuthorAccountId: p.ObjectAttributes.AuthorId,
+	}
+	if p.User.Id != 0 && p.User.Id == p.ObjectAttributes.AuthorId {
+		ev.AuthorLogin = p.User.Username
+	}
+	switch p.ObjectAttributes.Action {
+	case "open", "reopen":
+		ev.Action = ActionOpened
+	case "merge":
+		ev.Action = ActionMerged
+	case "close":
+		ev.Action = ActionClosed
+	default:
+		return Event{}, false, nil
+	}
+	return ev, true, nil
+}
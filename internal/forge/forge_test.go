@@ -0,0 +1,98 @@
+package forge
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func fixture(t *testing.T, name string) []byte {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+	return body
+}
+
+func TestVerifyGitHub(t *testing.T) {
+	body := fixture(t, "github_pull_request_opened.json")
+	mac := hmac.New(sha256.New, []byte("s3cret"))
+	mac.Write(body)
+	signature := "sha256=" + hex.EncodeToString(mac.Sum(nil))
+
+	assert.True(t, VerifyGitHub("s3cret", body, signature))
+	assert.False(t, VerifyGitHub("other", body, signature))
+	assert.False(t, VerifyGitHub("s3cret", append(body, ' '), signature))
+	assert.False(t, VerifyGitHub("s3cret", body, hex.EncodeToString(mac.Sum(nil))))
+	assert.False(t, VerifyGitHub("s3cret", body, "sha256=zz"))
+	assert.False(t, VerifyGitHub("", body, signature))
+}
+
+func TestVerifyGitLab(t *testing.T) {
+	assert.True(t, VerifyGitLab("token", "token"))
+	assert.False(t, VerifyGitLab("token", "tokem"))
+	assert.False(t, VerifyGitLab("", ""))
+}
+
+func TestParseGitHub(t *testing.T) {
+	tests := []struct {
+		fixture string
+		event   string
+		want    Event
+		ok      bool
+	}{
+		{"github_pull_request_opened.json", "pull_request", Event{
+			Forge: GitHub, Action: ActionOpened, PullRequestId: "github:acme/payments#42", Title: "Add refunds endpoint", AuthorLogin: "alice-gh",
+		}, true},
+		{"github_pull_request_closed_merged.json", "pull_request", Event{
+			Forge: GitHub, Action: ActionMerged, PullRequestId: "github:acme/payments#42", Title: "Add refunds endpoint", AuthorLogin: "alice-gh",
+		}, true},
+		{"github_pull_request_labeled.json", "pull_request", Event{}, false},
+		{"github_pull_request_opened.json", "push", Event{}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.fixture+"/"+tt.event, func(t *testing.T) {
+			ev, ok, err := ParseGitHub(tt.event, fixture(t, tt.fixture))
+			require.NoError(t, err)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.want, ev)
+		})
+	}
+
+	_, _, err := ParseGitHub("pull_request", []byte(`{"action":"opened"`))
+	assert.Error(t, err)
+	_, _, err = ParseGitHub("pull_request", []byte(`{"action":"opened"}`))
+	assert.Error(t, err)
+}
+
+func TestParseGitLab(t *testing.T) {
+	ev, ok, err := ParseGitLab("Merge Request Hook", fixture(t, "gitlab_merge_request_open.json"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, Event{
+		Forge: GitLab, Action: ActionOpened, PullRequestId: "gitlab:platform/payments!7", Title: "Add refunds endpoint", AuthorLogin: "alice-gl", AuthorAccountId: 17,
+	}, ev)
+
+	// MR Алисы переоткрыл Боб: user - это Боб, поэтому автор известен только по author_id
+	ev, ok, err = ParseGitLab("Merge Request Hook", fixture(t, "gitlab_merge_request_reopen.json"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, Event{
+		Forge: GitLab, Action: ActionOpened, PullRequestId: "gitlab:platform/payments!8", Title: "Batch payouts", AuthorAccountId: 17,
+	}, ev)
+
+	ev, ok, err = ParseGitLab("Merge Request Hook", fixture(t, "gitlab_merge_request_merge.json"))
+	require.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, ActionMerged, ev.Action)
+	assert.Equal(t, "gitlab:platform/payments!7", ev.PullRequestId)
+
+	_, ok, err = ParseGitLab("Push Hook", fixture(t, "gitlab_merge_request_open.json"))
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/models"
+	"avito-internship/internal/service"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ActorHeader называет автора изменения для журнала аудита
+const ActorHeader = "X-Actor"
+
+// anonymousActor записывается, если запрос не передал ActorHeader
+const anonymousActor = "anonymous"
+
+// as возвращает сервис, который записывает изменения в журнал аудита от имени автора запроса
+func (h *Handlers) as(ctx echo.Context) *service.Service {
+	actor := ctx.Request().Header.Get(ActorHeader)
+	if actor == "" {
+		actor = anonymousActor
+	}
+	return h.service.WithActor(actor)
+}
+
+func (h *Handlers) GetAudit(ctx echo.Context, params api.GetAuditParams) error {
+	var filter models.AuditFilter
+	if params.PullRequestId != nil {
+		filter.PullRequestId = *params.PullRequestId
+	}
+	if params.UserId != nil {
+		filter.UserId = *params.UserId
+	}
+	if params.TeamName != nil {
+		filter.TeamName = *params.TeamName
+	}
+	if params.From != nil {
+		filter.From = *params.From
+	}
+	if params.To != nil {
+		filter.To = *params.To
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	entries, err := h.service.GetAuditLog(filter)
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		Entries []api.AuditEntry `json:"entries"`
+	}{
+		Entries: make([]api.AuditEntry, len(entries)),
+	}
+	for i, e := range entries {
+		if resp.Entries[i], err = auditEntryToAPI(e); err != nil {
+			return err
+		}
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func auditEntryToAPI(entry models.AuditEntry) (api.AuditEntry, error) {
+	resp := api.AuditEntry{
+		AuditId:    entry.Id,
+		OccurredAt: entry.OccurredAt,
+		Actor:      entry.Actor,
+		Action:     entry.Action,
+		TargetType: api.AuditEntryTargetType(entry.TargetType),
+		TargetId:   entry.TargetId,
+		UserIds:    append([]string{}, entry.UserIds...),
+		Reason:     api.AuditEntryReason(entry.Reason),
+	}
+	if entry.PullRequestId != "" {
+		resp.PullRequestId = &entry.PullRequestId
+	}
+	if entry.TeamName != "" {
+		resp.TeamName = &entry.TeamName
+	}
+	if entry.Error != "" {
+		resp.Error = &entry.Error
+	}
+	var err error
+	if resp.Before, err = auditState(entry.Before); err != nil {
+		return api.AuditEntry{}, err
+	}
+	if resp.After, err = auditState(entry.After); err != nil {
+		return api.AuditEntry{}, err
+	}
+	return resp, nil
+}
+
+func auditState(raw []byte) (*map[string]interface{}, error) {
+	if raw == nil {
+		return nil, nil
+	}
+	var state map[string]interface{}
+	if err := json.Unmarshal(raw, &state); err != nil {
+		return nil, err
+	}
+	return &state, nil
+}
@@ -0,0 +1,61 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/storage"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLog(t *testing.T) {
+	e := newTestEcho(t, storage.NewInMemStorage())
+
+	req := httptest.NewRequest(http.MethodPost, "/team/add", strings.NewReader(`{"team_name":"backend","members":[
+		{"user_id":"u1","username":"Alice","is_active":true},
+		{"user_id":"u2","username":"Bob","is_active":true}]}`))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	req.Header.Set(ActorHeader, "lead@example.com")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = postJSON(e, "/users/setIsActive", `{"user_id":"u2","is_active":false}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/audit?team_name=backend", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp struct {
+		Entries []api.AuditEntry `json:"entries"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Entries, 2)
+	deactivated, created := resp.Entries[0], resp.Entries[1]
+	assert.Equal(t, "user.deactivated", deactivated.Action)
+	assert.Equal(t, anonymousActor, deactivated.Actor)
+	assert.Equal(t, api.AuditEntryTargetType("user"), deactivated.TargetType)
+	require.NotNil(t, deactivated.Before)
+	require.NotNil(t, deactivated.After)
+	assert.Equal(t, true, (*deactivated.Before)["is_active"])
+	assert.Equal(t, false, (*deactivated.After)["is_active"])
+	assert.Equal(t, "team.created", created.Action)
+	assert.Equal(t, "lead@example.com", created.Actor)
+	assert.Equal(t, api.AuditEntryReason("manual"), created.Reason)
+	assert.Equal(t, []string{"u1", "u2"}, created.UserIds)
+	assert.Nil(t, created.Before)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/audit?user_id=u1&from=2030-01-01T00:00:00Z", nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"entries":[]}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/audit?from=2030-01-01T00:00:00Z&to=2029-01-01T00:00:00Z", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
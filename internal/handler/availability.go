@@ -0,0 +1,121 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/models"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handlers) PostUsersAddAbsence(ctx echo.Context) error {
+	var req api.PostUsersAddAbsenceJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	absence, err := h.as(ctx).AddAbsence(models.Absence{
+		UserId:   req.UserId,
+		Kind:     string(req.Kind),
+		StartsAt: req.StartsAt,
+		EndsAt:   req.EndsAt,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, absenceToAPI(absence))
+}
+
+func (h *Handlers) GetUsersGetAbsences(ctx echo.Context, params api.GetUsersGetAbsencesParams) error {
+	absences, err := h.service.GetAbsences(params.UserId)
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		UserId   string        `json:"user_id"`
+		Absences []api.Absence `json:"absences"`
+	}{
+		UserId:   params.UserId,
+		Absences: make([]api.Absence, len(absences)),
+	}
+	for i, a := range absences {
+		resp.Absences[i] = absenceToAPI(a)
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handlers) PostUsersDeleteAbsence(ctx echo.Context) error {
+	var req api.PostUsersDeleteAbsenceJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.as(ctx).DeleteAbsence(req.AbsenceId); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (h *Handlers) GetUsersGetWorkingHours(ctx echo.Context, params api.GetUsersGetWorkingHoursParams) error {
+	hours, ok, err := h.service.GetWorkingHours(params.UserId)
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		UserId       string            `json:"user_id"`
+		WorkingHours *api.WorkingHours `json:"working_hours"`
+	}{UserId: params.UserId}
+	if ok {
+		apiHours := workingHoursToAPI(hours)
+		resp.WorkingHours = &apiHours
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handlers) PostUsersSetWorkingHours(ctx echo.Context) error {
+	var req api.PostUsersSetWorkingHoursJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	hours, err := h.as(ctx).SetWorkingHours(models.WorkingHours{
+		UserId:   req.UserId,
+		Timezone: req.Timezone,
+		Weekdays: req.Weekdays,
+		Start:    req.Start,
+		End:      req.End,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, workingHoursToAPI(hours))
+}
+
+func (h *Handlers) PostUsersDeleteWorkingHours(ctx echo.Context) error {
+	var req api.PostUsersDeleteWorkingHoursJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.as(ctx).DeleteWorkingHours(req.UserId); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func absenceToAPI(absence models.Absence) api.Absence {
+	return api.Absence{
+		AbsenceId: absence.Id,
+		UserId:    absence.UserId,
+		Kind:      api.AbsenceKind(absence.Kind),
+		StartsAt:  absence.StartsAt,
+		EndsAt:    absence.EndsAt,
+		Processed: absence.Processed,
+	}
+}
+
+func workingHoursToAPI(hours models.WorkingHours) api.WorkingHours {
+	return api.WorkingHours{
+		UserId:   hours.UserId,
+		Timezone: hours.Timezone,
+		Weekdays: hours.Weekdays,
+		Start:    hours.Start,
+		End:      hours.End,
+	}
+}
@@ -0,0 +1,134 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/models"
+	"avito-internship/internal/service"
+	"avito-internship/internal/storage"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEcho(t *testing.T, repo *storage.InMemStorage) *echo.Echo {
+	t.Helper()
+	e := echo.New()
+	e.HTTPErrorHandler = ErrorHandler
+	api.RegisterHandlers(e, NewHandlers(service.NewService(repo)))
+	return e
+}
+
+func postJSON(e *echo.Echo, path, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, path, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestConcurrentReassignAndMerge(t *testing.T) {
+	repo := storage.NewInMemStorage()
+	members := []models.TeamMember{{UserId: "author", Username: "author", IsActive: true}}
+	for i := 0; i < 10; i++ {
+		members = append(members, models.TeamMember{UserId: fmt.Sprintf("u%d", i), Username: fmt.Sprintf("user%d", i), IsActive: true})
+	}
+	require.NoError(t, repo.AddTeam("team1", members))
+	e := newTestEcho(t, repo)
+
+	for p := 0; p < 5; p++ {
+		prId := fmt.Sprintf("pr-%d", p)
+		rec := postJSON(e, "/pullRequest/create", fmt.Sprintf(`{"pull_request_id":%q,"pull_request_name":"PR","author_id":"author"}`, prId))
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var wg sync.WaitGroup
+		codes := make(chan int, 64)
+		for g := 0; g < 40; g++ {
+			wg.Add(1)
+			go func(g int) {
+				defer wg.Done()
+				var rec *httptest.ResponseRecorder
+				if g == 20 {
+					rec = postJSON(e, "/pullRequest/merge", fmt.Sprintf(`{"pull_request_id":%q}`, prId))
+				} else {
+					rec = postJSON(e, "/pullRequest/reassign", fmt.Sprintf(`{"pull_request_id":%q,"old_user_id":"u%d"}`, prId, g%10))
+				}
+				codes <- rec.Code
+				if rec.Code == http.StatusConflict {
+					var resp api.ErrorResponse
+					assert.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+					assert.Contains(t, []api.ErrorResponseErrorCode{api.CONFLICT, api.PRMERGED, api.NOTASSIGNED, api.NOCANDIDATE}, resp.Error.Code)
+				}
+			}(g)
+		}
+		wg.Wait()
+		close(codes)
+		for code := range codes {
+			assert.Contains(t, []int{http.StatusOK, http.StatusConflict}, code)
+		}
+
+		pr, err := repo.GetPR(prId)
+		require.NoError(t, err)
+		assert.Equal(t, "MERGED", pr.Status)
+		require.NotNil(t, pr.MergedAt)
+		assert.Len(t, pr.AssignedReviewers, 2)
+
+		// После merge назначения не меняются, а активный ревьювер не бывает назначен дважды
+		assignments, err := repo.GetAssignments(prId)
+		require.NoError(t, err)
+		active := map[string]bool{}
+		for _, a := range assignments {
+			assert.False(t, a.AssignedAt.After(*pr.MergedAt))
+			if a.UnassignedAt != nil {
+				assert.False(t, a.UnassignedAt.After(*pr.MergedAt))
+				continue
+			}
+			assert.False(t, active[a.UserId])
+			assert.NotEqual(t, "author", a.UserId)
+			active[a.UserId] = true
+		}
+		assert.Len(t, active, 2)
+	}
+}
+
+func TestConcurrentTeamSettingsPatches(t *testing.T) {
+	repo := storage.NewInMemStorage()
+	require.NoError(t, repo.AddTeam("team1", []models.TeamMember{{UserId: "u1", Username: "user1", IsActive: true}}))
+	e := newTestEcho(t, repo)
+
+	// Каждый запрос меняет своё поле; ни одно изменение не должно потеряться
+	patches := []string{
+		`{"team_name":"team1","min_approvals":1}`,
+		`{"team_name":"team1","require_lead_approval":true}`,
+		`{"team_name":"team1","max_open_reviews":5}`,
+		`{"team_name":"team1","review_sla_minutes":60}`,
+		`{"team_name":"team1","reviewer_strategy":"round_robin"}`,
+	}
+	var wg sync.WaitGroup
+	for _, body := range patches {
+		wg.Add(1)
+		go func(body string) {
+			defer wg.Done()
+			rec := postJSON(e, "/team/setSettings", body)
+			assert.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+		}(body)
+	}
+	wg.Wait()
+
+	settings, err := repo.GetTeamSettings("team1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, settings.MinApprovals)
+	assert.True(t, settings.RequireLeadApproval)
+	assert.Equal(t, 5, settings.MaxOpenReviews)
+	assert.Equal(t, time.Hour, settings.ReviewSLA)
+	assert.Equal(t, "round_robin", settings.ReviewerStrategy)
+	assert.Equal(t, len(patches), settings.Version)
+}
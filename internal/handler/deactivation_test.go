@@ -0,0 +1,149 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/storage"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeactivateTeamReport(t *testing.T) {
+	e := newTestEcho(t, storage.NewInMemStorage())
+
+	rec := postJSON(e, "/team/add", `{"team_name":"backend","members":[
+		{"user_id":"u1","username":"Alice","is_active":true},
+		{"user_id":"u2","username":"Bob","is_active":true}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = postJSON(e, "/pullRequest/create", `{"pull_request_id":"pr1","pull_request_name":"Feature","author_id":"u1"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = postJSON(e, "/team/deactivate", `{"team_name":"backend","dry_run":true}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.JSONEq(t, `{
+		"team_name": "backend",
+		"dry_run": true,
+		"deactivated_users": ["u1", "u2"],
+		"reassignments": [{"pull_request_id": "pr1", "old_reviewer_id": "u2", "reason": "no active replacement candidate in team"}],
+		"under_reviewed": [{"pull_request_id": "pr1", "active_reviewers": [], "min_reviewers": 0}]
+	}`, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/team/get?team_name=backend", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var team api.Team
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &team))
+	for _, m := range team.Members {
+		assert.True(t, m.IsActive, m.UserId)
+	}
+
+	rec = postJSON(e, "/team/deactivate", `{"team_name":"backend"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var report api.DeactivationReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.False(t, report.DryRun)
+	assert.Len(t, report.Reassignments, 1)
+}
+
+func TestSetIsActiveDryRun(t *testing.T) {
+	e := newTestEcho(t, storage.NewInMemStorage())
+
+	rec := postJSON(e, "/team/add", `{"team_name":"backend","members":[
+		{"user_id":"u1","username":"Alice","is_active":true},
+		{"user_id":"u2","username":"Bob","is_active":true},
+		{"user_id":"u3","username":"Carol","is_active":true}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = postJSON(e, "/pullRequest/create", `{"pull_request_id":"pr1","pull_request_name":"Feature","author_id":"u1","reviewer_count":1}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var pr api.PullRequest
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &pr))
+	require.Len(t, pr.AssignedReviewers, 1)
+	reviewer := pr.AssignedReviewers[0]
+	replacement := map[string]string{"u2": "u3", "u3": "u2"}[reviewer]
+
+	var resp struct {
+		User   api.User               `json:"user"`
+		Report api.DeactivationReport `json:"report"`
+	}
+	rec = postJSON(e, "/users/setIsActive", `{"user_id":"`+reviewer+`","is_active":false,"dry_run":true}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.False(t, resp.User.IsActive)
+	assert.True(t, resp.Report.DryRun)
+	assert.Equal(t, []string{reviewer}, resp.Report.DeactivatedUsers)
+	require.Len(t, resp.Report.Reassignments, 1)
+	assert.Equal(t, &replacement, resp.Report.Reassignments[0].NewReviewerId)
+	assert.Empty(t, resp.Report.UnderReviewed)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/users/getReview?user_id="+reviewer, nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), `"pr1"`)
+
+	rec = postJSON(e, "/users/setIsActive", `{"user_id":"`+reviewer+`","is_active":false}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.False(t, resp.Report.DryRun)
+	require.Len(t, resp.Report.Reassignments, 1)
+	assert.Equal(t, &replacement, resp.Report.Reassignments[0].NewReviewerId)
+}
+
+func TestTeamRebalance(t *testing.T) {
+	e := newTestEcho(t, storage.NewInMemStorage())
+
+	rec := postJSON(e, "/team/add", `{"team_name":"backend","members":[
+		{"user_id":"u1","username":"Alice","is_active":true},
+		{"user_id":"u2","username":"Bob","is_active":true},
+		{"user_id":"u3","username":"Carol","is_active":true},
+		{"user_id":"u4","username":"Dave","is_active":false}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	for _, id := range []string{"pr1", "pr2"} {
+		rec = postJSON(e, "/pullRequest/create", `{"pull_request_id":"`+id+`","pull_request_name":"Feature","author_id":"u1"}`)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	}
+
+	var resp struct {
+		User   api.User               `json:"user"`
+		Report api.DeactivationReport `json:"report"`
+	}
+	rec = postJSON(e, "/users/setIsActive", `{"user_id":"u4","is_active":true,"rebalance":true,"dry_run":true}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.NotNil(t, resp.Report.Rebalance)
+	assert.Len(t, resp.Report.Rebalance.Moves, 1)
+
+	rec = postJSON(e, "/users/setIsActive", `{"user_id":"u4","is_active":true}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = postJSON(e, "/team/rebalance", `{"team_name":"backend","dry_run":true}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.JSONEq(t, `{
+		"team_name": "backend",
+		"dry_run": true,
+		"moves": [{"pull_request_id": "pr1", "old_reviewer_id": "u2", "new_reviewer_id": "u4"}],
+		"loads": [
+			{"user_id": "u1", "before": 0, "after": 0},
+			{"user_id": "u2", "before": 2, "after": 1},
+			{"user_id": "u3", "before": 2, "after": 2},
+			{"user_id": "u4", "before": 0, "after": 1}
+		]
+	}`, rec.Body.String())
+
+	rec = postJSON(e, "/team/rebalance", `{"team_name":"backend"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var report api.RebalanceReport
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &report))
+	assert.False(t, report.DryRun)
+	assert.Len(t, report.Moves, 1)
+
+	rec = postJSON(e, "/team/rebalance", `{"team_name":"backend"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	assert.Contains(t, rec.Body.String(), `"moves":[]`)
+
+	rec = postJSON(e, "/team/rebalance", `{"team_name":"unknown"}`)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
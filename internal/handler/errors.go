@@ -0,0 +1,104 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/errs"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type errorMapping struct {
+	kind       error
+	httpStatus int
+	grpcCode   codes.Code
+	code       api.ErrorResponseErrorCode
+}
+
+// Более конкретные категории идут раньше общих: ErrTeamExists и ErrPRExists оборачивают ErrAlreadyExists
+var errorMappings = []errorMapping{
+	{errs.ErrNotFound, http.StatusNotFound, codes.NotFound, api.NOTFOUND},
+	{errs.ErrTeamExists, http.StatusBadRequest, codes.AlreadyExists, api.TEAMEXISTS},
+	{errs.ErrPRExists, http.StatusConflict, codes.AlreadyExists, api.PREXISTS},
+	{errs.ErrAlreadyExists, http.StatusConflict, codes.AlreadyExists, api.ALREADYEXISTS},
+	{errs.ErrMerged, http.StatusConflict, codes.FailedPrecondition, api.PRMERGED},
+	{errs.ErrNotAssigned, http.StatusConflict, codes.FailedPrecondition, api.NOTASSIGNED},
+	{errs.ErrNoCandidate, http.StatusConflict, codes.FailedPrecondition, api.NOCANDIDATE},
+	{errs.ErrMergeBlocked, http.StatusConflict, codes.FailedPrecondition, api.MERGEBLOCKED},
+	{errs.ErrValidation, http.StatusBadRequest, codes.InvalidArgument, api.VALIDATIONERROR},
+	{errs.ErrConflict, http.StatusConflict, codes.Aborted, api.CONFLICT},
+	{errs.ErrUnauthorized, http.StatusUnauthorized, codes.Unauthenticated, api.UNAUTHORIZED},
+}
+
+var internalMapping = errorMapping{errs.ErrInternal, http.StatusInternalServerError, codes.Internal, api.INTERNAL}
+
+func mapError(err error) errorMapping {
+	for _, m := range errorMappings {
+		if errors.Is(err, m.kind) {
+			return m
+		}
+	}
+	return internalMapping
+}
+
+// ErrorHandler переводит ошибки обработчиков в ErrorResponse; неизвестные ошибки отдаются как INTERNAL без подробностей
+func ErrorHandler(err error, ctx echo.Context) {
+	if ctx.Response().Committed {
+		return
+	}
+	status, code, message := http.StatusInternalServerError, api.INTERNAL, "internal error"
+	var he *echo.HTTPError
+	if errors.As(err, &he) {
+		// Ошибки самого Echo и привязки параметров: неизвестный маршрут, невалидный JSON, пропущенный query-параметр
+		status, message = he.Code, fmt.Sprint(he.Message)
+		switch {
+		case he.Code == http.StatusNotFound:
+			code = api.NOTFOUND
+		case he.Code < http.StatusInternalServerError:
+			code = api.VALIDATIONERROR
+		}
+	} else if m := mapError(err); m.kind != errs.ErrInternal {
+		status, code, message = m.httpStatus, m.code, err.Error()
+	}
+	if status >= http.StatusInternalServerError {
+		ctx.Logger().Error(err)
+	}
+
+	resp := api.ErrorResponse{}
+	resp.Error.Code = code
+	resp.Error.Message = message
+	if details := errs.Details(err); len(details) > 0 {
+		resp.Error.Details = &details
+	}
+	if ctx.Request().Method == http.MethodHead {
+		err = ctx.NoContent(status)
+	} else {
+		err = ctx.JSON(status, resp)
+	}
+	if err != nil {
+		ctx.Logger().Error(err)
+	}
+}
+
+// grpcError кладёт код ошибки REST API в ErrorInfo, чтобы клиенты обоих транспортов различали ошибки одинаково
+func grpcError(err error) error {
+	m := mapError(err)
+	message := err.Error()
+	if m.kind == errs.ErrInternal {
+		message = "internal error"
+	}
+	st := status.New(m.grpcCode, message)
+	detailed, detailsErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: string(m.code),
+		Domain: "avito-internship",
+	})
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/storage"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func decodeError(t *testing.T, rec *httptest.ResponseRecorder) api.ErrorResponse {
+	t.Helper()
+	var resp api.ErrorResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp), rec.Body.String())
+	return resp
+}
+
+func TestErrorHandler(t *testing.T) {
+	e := newTestEcho(t, storage.NewInMemStorage())
+
+	rec := postJSON(e, "/team/add", `{"team_name":"backend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	tests := []struct {
+		name   string
+		path   string
+		body   string
+		status int
+		code   api.ErrorResponseErrorCode
+	}{
+		{"invalid body", "/team/add", `{"team_name":`, http.StatusBadRequest, api.VALIDATIONERROR},
+		{"team exists", "/team/add", `{"team_name":"backend","members":[]}`, http.StatusBadRequest, api.TEAMEXISTS},
+		{"user exists", "/team/add", `{"team_name":"frontend","members":[{"user_id":"u1","username":"Alice","is_active":true}]}`, http.StatusConflict, api.ALREADYEXISTS},
+		{"unknown author", "/pullRequest/create", `{"pull_request_id":"pr-1","pull_request_name":"PR","author_id":"unknown"}`, http.StatusNotFound, api.NOTFOUND},
+		{"unknown team", "/team/deactivate", `{"team_name":"unknown"}`, http.StatusNotFound, api.NOTFOUND},
+		{"unknown strategy", "/team/setSettings", `{"team_name":"backend","reviewer_strategy":"fastest"}`, http.StatusBadRequest, api.VALIDATIONERROR},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := postJSON(e, tt.path, tt.body)
+			assert.Equal(t, tt.status, rec.Code)
+			assert.Equal(t, tt.code, decodeError(t, rec).Error.Code)
+		})
+	}
+
+	rec = postJSON(e, "/team/setSettings", `{"team_name":"backend","min_approvals":1}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = postJSON(e, "/pullRequest/create", `{"pull_request_id":"pr-1","pull_request_name":"PR","author_id":"u1"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = postJSON(e, "/pullRequest/merge", `{"pull_request_id":"pr-1"}`)
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	resp := decodeError(t, rec)
+	assert.Equal(t, api.MERGEBLOCKED, resp.Error.Code)
+	require.NotNil(t, resp.Error.Details)
+	assert.Equal(t, []string{"0 of 1 required approvals"}, *resp.Error.Details)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/team/get", nil))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, api.VALIDATIONERROR, decodeError(t, rec).Error.Code)
+}
+
+func TestErrorHandler_Internal(t *testing.T) {
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	ErrorHandler(errors.New("pq: connection refused"), e.NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec))
+
+	assert.Equal(t, http.StatusInternalServerError, rec.Code)
+	resp := decodeError(t, rec)
+	assert.Equal(t, api.INTERNAL, resp.Error.Code)
+	assert.Equal(t, "internal error", resp.Error.Message)
+}
@@ -0,0 +1,151 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/errs"
+	"avito-internship/internal/forge"
+	"avito-internship/internal/models"
+	"io"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+// ForgeSecrets - секрет подписи вебхуков GitHub и секретный токен вебхуков GitLab
+type ForgeSecrets struct {
+	GitHub string
+	GitLab string
+}
+
+// maxForgePayload ограничивает тело входящего вебхука; GitHub присылает не больше 25 МБ, но событиям PR хватает меньшего
+const maxForgePayload = 5 << 20
+
+func (h *Handlers) PostForgeGithub(ctx echo.Context, params api.PostForgeGithubParams) error {
+	if h.forgeSecrets.GitHub == "" {
+		return errs.New(errs.ErrUnauthorized, "github webhooks are not configured")
+	}
+	body, err := readForgePayload(ctx)
+	if err != nil {
+		return err
+	}
+	var signature string
+	if params.XHubSignature256 != nil {
+		signature = *params.XHubSignature256
+	}
+	if !forge.VerifyGitHub(h.forgeSecrets.GitHub, body, signature) {
+		return errs.New(errs.ErrUnauthorized, "invalid webhook signature")
+	}
+	ev, ok, err := forge.ParseGitHub(params.XGitHubEvent, body)
+	return h.handleForgeEvent(ctx, ev, ok, err)
+}
+
+func (h *Handlers) PostForgeGitlab(ctx echo.Context, params api.PostForgeGitlabParams) error {
+	if h.forgeSecrets.GitLab == "" {
+		return errs.New(errs.ErrUnauthorized, "gitlab webhooks are not configured")
+	}
+	var token string
+	if params.XGitlabToken != nil {
+		token = *params.XGitlabToken
+	}
+	if !forge.VerifyGitLab(h.forgeSecrets.GitLab, token) {
+		return errs.New(errs.ErrUnauthorized, "invalid webhook token")
+	}
+	body, err := readForgePayload(ctx)
+	if err != nil {
+		return err
+	}
+	ev, ok, err := forge.ParseGitLab(params.XGitlabEvent, body)
+	return h.handleForgeEvent(ctx, ev, ok, err)
+}
+
+func (h *Handlers) handleForgeEvent(ctx echo.Context, ev forge.Event, ok bool, parseErr error) error {
+	if parseErr != nil {
+		return errs.New(errs.ErrValidation, parseErr.Error())
+	}
+	result := models.ForgeEventResult{Outcome: models.ForgeOutcomeIgnored, Reason: "event is not tracked"}
+	if ok {
+		var err error
+		if result, err = h.service.WithActor(ev.Forge).HandleForgeEvent(ev); err != nil {
+			return err
+		}
+	}
+	resp := api.ForgeEventResult{Outcome: api.ForgeEventResultOutcome(result.Outcome)}
+	if result.PullRequestId != "" {
+		resp.PullRequestId = &result.PullRequestId
+	}
+	if result.Reason != "" {
+		resp.Reason = &result.Reason
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+// readForgePayload читает тело целиком: подпись GitHub считается по исходным байтам
+func readForgePayload(ctx echo.Context) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(ctx.Request().Body, maxForgePayload+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxForgePayload {
+		return nil, errs.New(errs.ErrValidation, "payload is too large")
+	}
+	return body, nil
+}
+
+func (h *Handlers) GetForgeIdentities(ctx echo.Context) error {
+	identities, err := h.service.GetForgeIdentities()
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		Identities []api.ForgeIdentity `json:"identities"`
+	}{
+		Identities: make([]api.ForgeIdentity, len(identities)),
+	}
+	for i, identity := range identities {
+		resp.Identities[i] = forgeIdentityToAPI(identity)
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handlers) PostForgeSetIdentity(ctx echo.Context) error {
+	var req api.PostForgeSetIdentityJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	identity := models.ForgeIdentity{
+		Forge:    string(req.Forge),
+		Username: req.Username,
+		UserId:   req.UserId,
+	}
+	if req.AccountId != nil {
+		identity.AccountId = *req.AccountId
+	}
+	identity, err := h.as(ctx).SetForgeIdentity(identity)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, forgeIdentityToAPI(identity))
+}
+
+func (h *Handlers) PostForgeDeleteIdentity(ctx echo.Context) error {
+	var req api.PostForgeDeleteIdentityJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.as(ctx).DeleteForgeIdentity(string(req.Forge), req.Username); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func forgeIdentityToAPI(identity models.ForgeIdentity) api.ForgeIdentity {
+	resp := api.ForgeIdentity{
+		Forge:    api.ForgeName(identity.Forge),
+		Username: identity.Username,
+		UserId:   identity.UserId,
+	}
+	if identity.AccountId != 0 {
+		resp.AccountId = &identity.AccountId
+	}
+	return resp
+}
@@ -0,0 +1,132 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/service"
+	"avito-internship/internal/storage"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func postForge(t *testing.T, e *echo.Echo, path, fixture string, headers map[string]string) *httptest.ResponseRecorder {
+	t.Helper()
+	body, err := os.ReadFile(filepath.Join("..", "forge", "testdata", fixture))
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	for k, v := range headers {
+		if v == "sign" {
+			mac := hmac.New(sha256.New, []byte("gh-secret"))
+			mac.Write(body)
+			v = "sha256=" + hex.EncodeToString(mac.Sum(nil))
+		}
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	return rec
+}
+
+func decodeForgeResult(t *testing.T, rec *httptest.ResponseRecorder) api.ForgeEventResult {
+	t.Helper()
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp api.ForgeEventResult
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	return resp
+}
+
+func TestForgeWebhooks(t *testing.T) {
+	repo := storage.NewInMemStorage()
+	e := echo.New()
+	e.HTTPErrorHandler = ErrorHandler
+	api.RegisterHandlers(e, NewHandlers(service.NewService(repo)).WithForgeSecrets(ForgeSecrets{GitHub: "gh-secret", GitLab: "gl-token"}))
+
+	rec := postJSON(e, "/team/add", `{"team_name":"payments","members":[
+		{"user_id":"u1","username":"Alice","is_active":true},
+		{"user_id":"u2","username":"Bob","is_active":true},
+		{"user_id":"u3","username":"Carol","is_active":true}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	github := map[string]string{"X-GitHub-Event": "pull_request", "X-Hub-Signature-256": "sign"}
+	rec = postForge(t, e, "/forge/github", "github_pull_request_opened.json", map[string]string{"X-GitHub-Event": "pull_request", "X-Hub-Signature-256": "sha256=00"})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Equal(t, api.UNAUTHORIZED, decodeError(t, rec).Error.Code)
+
+	// Автор ещё не сопоставлен с user_id
+	rec = postForge(t, e, "/forge/github", "github_pull_request_opened.json", github)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	rec = postJSON(e, "/forge/setIdentity", `{"forge":"github","username":"alice-gh","user_id":"u1"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = postJSON(e, "/forge/setIdentity", `{"forge":"gitlab","username":"alice-gl","user_id":"u1"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	result := decodeForgeResult(t, postForge(t, e, "/forge/github", "github_pull_request_opened.json", github))
+	assert.Equal(t, api.ForgeEventResultOutcomeCreated, result.Outcome)
+	require.NotNil(t, result.PullRequestId)
+	assert.Equal(t, "github:acme/payments#42", *result.PullRequestId)
+	pr, err := repo.GetPR("github:acme/payments#42")
+	require.NoError(t, err)
+	assert.Equal(t, "u1", pr.AuthorId)
+	assert.Equal(t, "Add refunds endpoint", pr.PullRequestName)
+	assert.Len(t, pr.AssignedReviewers, 2)
+
+	result = decodeForgeResult(t, postForge(t, e, "/forge/github", "github_pull_request_opened.json", github))
+	assert.Equal(t, api.ForgeEventResultOutcomeIgnored, result.Outcome)
+	result = decodeForgeResult(t, postForge(t, e, "/forge/github", "github_pull_request_labeled.json", github))
+	assert.Equal(t, api.ForgeEventResultOutcomeIgnored, result.Outcome)
+
+	result = decodeForgeResult(t, postForge(t, e, "/forge/github", "github_pull_request_closed_merged.json", github))
+	assert.Equal(t, api.ForgeEventResultOutcomeMerged, result.Outcome)
+	pr, err = repo.GetPR("github:acme/payments#42")
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", pr.Status)
+
+	gitlab := map[string]string{"X-Gitlab-Event": "Merge Request Hook", "X-Gitlab-Token": "gl-token"}
+	rec = postForge(t, e, "/forge/gitlab", "gitlab_merge_request_open.json", map[string]string{"X-Gitlab-Event": "Merge Request Hook"})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	result = decodeForgeResult(t, postForge(t, e, "/forge/gitlab", "gitlab_merge_request_open.json", gitlab))
+	assert.Equal(t, api.ForgeEventResultOutcomeCreated, result.Outcome)
+	// Политика команды требует одобрения, но MR уже слит в GitLab
+	rec = postJSON(e, "/team/setSettings", `{"team_name":"payments","min_approvals":1}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	result = decodeForgeResult(t, postForge(t, e, "/forge/gitlab", "gitlab_merge_request_merge.json", gitlab))
+	assert.Equal(t, api.ForgeEventResultOutcomeMerged, result.Outcome)
+	pr, err = repo.GetPR("gitlab:platform/payments!7")
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", pr.Status)
+	assert.True(t, pr.ForceMerged)
+
+	// MR Алисы переоткрыл Боб: автор ищется по id аккаунта, а не по тому, кто вызвал событие
+	rec = postJSON(e, "/forge/setIdentity", `{"forge":"gitlab","username":"bob-gl","user_id":"u2"}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = postForge(t, e, "/forge/gitlab", "gitlab_merge_request_reopen.json", gitlab)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	rec = postJSON(e, "/forge/setIdentity", `{"forge":"gitlab","username":"alice-gl","user_id":"u1","account_id":17}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = postJSON(e, "/forge/setIdentity", `{"forge":"gitlab","username":"bob-gl","user_id":"u2","account_id":17}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	result = decodeForgeResult(t, postForge(t, e, "/forge/gitlab", "gitlab_merge_request_reopen.json", gitlab))
+	assert.Equal(t, api.ForgeEventResultOutcomeCreated, result.Outcome)
+	pr, err = repo.GetPR("gitlab:platform/payments!8")
+	require.NoError(t, err)
+	assert.Equal(t, "u1", pr.AuthorId)
+}
+
+func TestForgeWebhooks_NotConfigured(t *testing.T) {
+	e := newTestEcho(t, storage.NewInMemStorage())
+	rec := postForge(t, e, "/forge/gitlab", "gitlab_merge_request_open.json", map[string]string{"X-Gitlab-Event": "Merge Request Hook", "X-Gitlab-Token": ""})
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
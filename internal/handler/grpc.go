@@ -0,0 +1,273 @@
+package handler
+
+import (
+	"avito-internship/api/reviewerpb"
+	"avito-internship/internal/models"
+	"avito-internship/internal/service"
+	"context"
+	"strings"
+
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type GRPCServer struct {
+	reviewerpb.UnimplementedReviewerServiceServer
+	service *service.Service
+}
+
+func NewGRPCServer(service *service.Service) *GRPCServer {
+	return &GRPCServer{service: service}
+}
+
+// as возвращает сервис, который записывает изменения в журнал аудита от имени автора из метаданных x-actor
+func (s *GRPCServer) as(ctx context.Context) *service.Service {
+	actor := anonymousActor
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get(strings.ToLower(ActorHeader)); len(values) > 0 && values[0] != "" {
+			actor = values[0]
+		}
+	}
+	return s.service.WithActor(actor)
+}
+
+func (s *GRPCServer) AddTeam(ctx context.Context, req *reviewerpb.AddTeamRequest) (*reviewerpb.AddTeamResponse, error) {
+	team := req.GetTeam()
+	members := make([]models.TeamMember, len(team.GetMembers()))
+	for i, m := range team.GetMembers() {
+		members[i] = models.TeamMember{
+			UserId:   m.GetUserId(),
+			Username: m.GetUsername(),
+			IsActive: m.GetIsActive(),
+		}
+	}
+	if err := s.as(ctx).AddTeam(team.GetTeamName(), members); err != nil {
+		return nil, grpcError(err)
+	}
+	return &reviewerpb.AddTeamResponse{Status: "ok"}, nil
+}
+
+func (s *GRPCServer) GetTeam(ctx context.Context, req *reviewerpb.GetTeamRequest) (*reviewerpb.Team, error) {
+	members, err := s.service.GetTeam(req.GetTeamName())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	resp := &reviewerpb.Team{
+		TeamName: req.GetTeamName(),
+		Members:  make([]*reviewerpb.TeamMember, len(members)),
+	}
+	for i, m := range members {
+		resp.Members[i] = &reviewerpb.TeamMember{
+			UserId:   m.UserId,
+			Username: m.Username,
+			IsActive: m.IsActive,
+		}
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) DeactivateTeam(ctx context.Context, req *reviewerpb.DeactivateTeamRequest) (*reviewerpb.DeactivateTeamResponse, error) {
+	report, err := s.as(ctx).DeactivateTeam(req.GetTeamName(), req.GetDryRun())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &reviewerpb.DeactivateTeamResponse{Status: "ok", Report: deactivationReportToProto(report)}, nil
+}
+
+func (s *GRPCServer) RebalanceTeam(ctx context.Context, req *reviewerpb.RebalanceTeamRequest) (*reviewerpb.RebalanceReport, error) {
+	report, err := s.as(ctx).RebalanceTeam(req.GetTeamName(), req.GetDryRun())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return rebalanceReportToProto(report), nil
+}
+
+func (s *GRPCServer) SetIsActive(ctx context.Context, req *reviewerpb.SetIsActiveRequest) (*reviewerpb.SetIsActiveResponse, error) {
+	user, report, err := s.as(ctx).SetUserActive(req.GetUserId(), req.GetIsActive(), req.GetDryRun(), req.GetRebalance())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &reviewerpb.SetIsActiveResponse{User: userToProto(user), Report: deactivationReportToProto(report)}, nil
+}
+
+func (s *GRPCServer) GetReview(ctx context.Context, req *reviewerpb.GetReviewRequest) (*reviewerpb.GetReviewResponse, error) {
+	prs, err := s.service.GetPRsByReviewer(req.GetUserId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	resp := &reviewerpb.GetReviewResponse{
+		UserId:       req.GetUserId(),
+		PullRequests: make([]*reviewerpb.PullRequestShort, len(prs)),
+	}
+	for i, pr := range prs {
+		resp.PullRequests[i] = &reviewerpb.PullRequestShort{
+			PullRequestId:   pr.PullRequestId,
+			PullRequestName: pr.PullRequestName,
+			AuthorId:        pr.AuthorId,
+			Status:          pr.Status,
+		}
+	}
+	return resp, nil
+}
+
+func (s *GRPCServer) CreatePullRequest(ctx context.Context, req *reviewerpb.CreatePullRequestRequest) (*reviewerpb.PullRequest, error) {
+	var reviewerCount *int
+	if req.ReviewerCount != nil {
+		count := int(req.GetReviewerCount())
+		reviewerCount = &count
+	}
+	pr, err := s.as(ctx).CreatePR(req.GetPullRequestId(), req.GetPullRequestName(), req.GetAuthorId(), reviewerCount, req.GetChangedFiles())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return pullRequestToProto(pr), nil
+}
+
+func (s *GRPCServer) MergePullRequest(ctx context.Context, req *reviewerpb.MergePullRequestRequest) (*reviewerpb.PullRequest, error) {
+	pr, err := s.as(ctx).MergePR(req.GetPullRequestId(), req.GetForce())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return pullRequestToProto(pr), nil
+}
+
+func (s *GRPCServer) ReassignPullRequest(ctx context.Context, req *reviewerpb.ReassignPullRequestRequest) (*reviewerpb.ReassignPullRequestResponse, error) {
+	pr, newReviewer, err := s.as(ctx).ReassignPR(req.GetPullRequestId(), req.GetOldUserId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return &reviewerpb.ReassignPullRequestResponse{
+		Pr:         pullRequestToProto(pr),
+		ReplacedBy: newReviewer,
+	}, nil
+}
+
+func (s *GRPCServer) GetPullRequest(ctx context.Context, req *reviewerpb.GetPullRequestRequest) (*reviewerpb.PullRequestDetail, error) {
+	details, err := s.service.GetPRDetails(req.GetPullRequestId())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return pullRequestDetailToProto(details), nil
+}
+
+func (s *GRPCServer) SubmitReview(ctx context.Context, req *reviewerpb.SubmitReviewRequest) (*reviewerpb.PullRequestDetail, error) {
+	details, err := s.as(ctx).SubmitReview(req.GetPullRequestId(), req.GetReviewerId(), req.GetState(), req.GetBody())
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	return pullRequestDetailToProto(details), nil
+}
+
+func (s *GRPCServer) GetAssignmentStats(ctx context.Context, req *reviewerpb.GetAssignmentStatsRequest) (*reviewerpb.GetAssignmentStatsResponse, error) {
+	stats, err := s.service.GetAssignmentStats()
+	if err != nil {
+		return nil, grpcError(err)
+	}
+	resp := &reviewerpb.GetAssignmentStatsResponse{
+		Stats: make([]*reviewerpb.AssignmentStat, len(stats)),
+	}
+	for i, stat := range stats {
+		resp.Stats[i] = &reviewerpb.AssignmentStat{
+			UserId: stat.UserId,
+			Count:  int32(stat.Count),
+		}
+	}
+	return resp, nil
+}
+
+func userToProto(user models.User) *reviewerpb.User {
+	return &reviewerpb.User{
+		UserId:         user.UserId,
+		Username:       user.Username,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		ReviewWeight:   int32(user.ReviewWeight),
+		IsLead:         user.IsLead,
+		MaxOpenReviews: int32(user.MaxOpenReviews),
+	}
+}
+
+func deactivationReportToProto(report models.DeactivationReport) *reviewerpb.DeactivationReport {
+	resp := &reviewerpb.DeactivationReport{
+		TeamName:         report.TeamName,
+		DryRun:           report.DryRun,
+		DeactivatedUsers: report.DeactivatedUsers,
+	}
+	for _, r := range report.Reassignments {
+		resp.Reassignments = append(resp.Reassignments, reassignmentToProto(r))
+	}
+	for _, pr := range report.UnderReviewed {
+		resp.UnderReviewed = append(resp.UnderReviewed, &reviewerpb.UnderReviewedPullRequest{
+			PullRequestId:   pr.PullRequestId,
+			ActiveReviewers: pr.ActiveReviewers,
+			MinReviewers:    int32(pr.MinReviewers),
+		})
+	}
+	if report.Rebalance != nil {
+		resp.Rebalance = rebalanceReportToProto(*report.Rebalance)
+	}
+	return resp
+}
+
+func rebalanceReportToProto(report models.RebalanceReport) *reviewerpb.RebalanceReport {
+	resp := &reviewerpb.RebalanceReport{TeamName: report.TeamName, DryRun: report.DryRun}
+	for _, m := range report.Moves {
+		resp.Moves = append(resp.Moves, reassignmentToProto(m))
+	}
+	for _, l := range report.Loads {
+		resp.Loads = append(resp.Loads, &reviewerpb.MemberLoad{UserId: l.UserId, Before: int32(l.Before), After: int32(l.After)})
+	}
+	return resp
+}
+
+func reassignmentToProto(r models.Reassignment) *reviewerpb.Reassignment {
+	return &reviewerpb.Reassignment{
+		PullRequestId: r.PullRequestId,
+		OldReviewerId: r.OldReviewerId,
+		NewReviewerId: r.NewReviewerId,
+		Reason:        r.Reason,
+	}
+}
+
+func pullRequestToProto(pr models.PullRequest) *reviewerpb.PullRequest {
+	resp := &reviewerpb.PullRequest{
+		PullRequestId:     pr.PullRequestId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorId,
+		Status:            pr.Status,
+		AssignedReviewers: pr.AssignedReviewers,
+		ForceMerged:       pr.ForceMerged,
+		UnderReviewed:     pr.UnderReviewed,
+		ReviewerTeams:     pr.ReviewerTeams,
+	}
+	if pr.CreatedAt != nil {
+		resp.CreatedAt = timestamppb.New(*pr.CreatedAt)
+	}
+	if pr.MergedAt != nil {
+		resp.MergedAt = timestamppb.New(*pr.MergedAt)
+	}
+	return resp
+}
+
+func pullRequestDetailToProto(details models.PullRequestDetails) *reviewerpb.PullRequestDetail {
+	resp := &reviewerpb.PullRequestDetail{
+		Pr:        pullRequestToProto(details.PullRequest),
+		Reviewers: make([]*reviewerpb.ReviewerState, len(details.Reviewers)),
+		Reviews:   make([]*reviewerpb.Review, len(details.Reviews)),
+	}
+	for i, r := range details.Reviewers {
+		resp.Reviewers[i] = &reviewerpb.ReviewerState{UserId: r.UserId, State: r.State}
+		if r.SubmittedAt != nil {
+			resp.Reviewers[i].SubmittedAt = timestamppb.New(*r.SubmittedAt)
+		}
+	}
+	for i, r := range details.Reviews {
+		resp.Reviews[i] = &reviewerpb.Review{
+			ReviewerId:  r.ReviewerId,
+			State:       r.State,
+			Body:        r.Body,
+			SubmittedAt: timestamppb.New(r.SubmittedAt),
+		}
+	}
+	return resp
+}
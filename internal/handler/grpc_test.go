@@ -0,0 +1,162 @@
+package handler
+
+import (
+	"avito-internship/api/reviewerpb"
+	"avito-internship/internal/service"
+	"avito-internship/internal/storage"
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func newGRPCClient(t *testing.T) reviewerpb.ReviewerServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	srv := grpc.NewServer()
+	reviewerpb.RegisterReviewerServiceServer(srv, NewGRPCServer(service.NewService(storage.NewInMemStorage())))
+	go srv.Serve(lis)
+	t.Cleanup(srv.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	t.Cleanup(func() { conn.Close() })
+	return reviewerpb.NewReviewerServiceClient(conn)
+}
+
+func errorReason(t *testing.T, err error) (codes.Code, string) {
+	t.Helper()
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	for _, d := range st.Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return st.Code(), info.Reason
+		}
+	}
+	return st.Code(), ""
+}
+
+func TestGRPCServer_PullRequestFlow(t *testing.T) {
+	client := newGRPCClient(t)
+	ctx := context.Background()
+
+	_, err := client.AddTeam(ctx, &reviewerpb.AddTeamRequest{Team: &reviewerpb.Team{
+		TeamName: "backend",
+		Members: []*reviewerpb.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+		},
+	}})
+	require.NoError(t, err)
+
+	_, err = client.AddTeam(ctx, &reviewerpb.AddTeamRequest{Team: &reviewerpb.Team{TeamName: "backend"}})
+	code, reason := errorReason(t, err)
+	assert.Equal(t, codes.AlreadyExists, code)
+	assert.Equal(t, "TEAM_EXISTS", reason)
+
+	pr, err := client.CreatePullRequest(ctx, &reviewerpb.CreatePullRequestRequest{
+		PullRequestId:   "pr-1",
+		PullRequestName: "Add search",
+		AuthorId:        "u1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2"}, pr.AssignedReviewers)
+	assert.NotNil(t, pr.CreatedAt)
+
+	_, err = client.ReassignPullRequest(ctx, &reviewerpb.ReassignPullRequestRequest{PullRequestId: "pr-1", OldUserId: "u2"})
+	code, reason = errorReason(t, err)
+	assert.Equal(t, codes.FailedPrecondition, code)
+	assert.Equal(t, "NO_CANDIDATE", reason)
+
+	detail, err := client.SubmitReview(ctx, &reviewerpb.SubmitReviewRequest{PullRequestId: "pr-1", ReviewerId: "u2", State: "APPROVED"})
+	require.NoError(t, err)
+	require.Len(t, detail.Reviews, 1)
+	assert.Equal(t, "APPROVED", detail.Reviewers[0].State)
+	assert.NotNil(t, detail.Reviewers[0].SubmittedAt)
+
+	_, err = client.SubmitReview(ctx, &reviewerpb.SubmitReviewRequest{PullRequestId: "pr-1", ReviewerId: "u1", State: "APPROVED"})
+	code, reason = errorReason(t, err)
+	assert.Equal(t, codes.FailedPrecondition, code)
+	assert.Equal(t, "NOT_ASSIGNED", reason)
+
+	detail, err = client.GetPullRequest(ctx, &reviewerpb.GetPullRequestRequest{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "pr-1", detail.Pr.PullRequestId)
+	assert.Len(t, detail.Reviews, 1)
+
+	merged, err := client.MergePullRequest(ctx, &reviewerpb.MergePullRequestRequest{PullRequestId: "pr-1"})
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", merged.Status)
+	assert.NotNil(t, merged.MergedAt)
+
+	review, err := client.GetReview(ctx, &reviewerpb.GetReviewRequest{UserId: "u2"})
+	require.NoError(t, err)
+	require.Len(t, review.PullRequests, 1)
+	assert.Equal(t, "pr-1", review.PullRequests[0].PullRequestId)
+
+	_, err = client.GetTeam(ctx, &reviewerpb.GetTeamRequest{TeamName: "unknown"})
+	code, reason = errorReason(t, err)
+	assert.Equal(t, codes.NotFound, code)
+	assert.Equal(t, "NOT_FOUND", reason)
+}
+
+func TestGRPCServer_RebalanceTeam(t *testing.T) {
+	client := newGRPCClient(t)
+	ctx := context.Background()
+
+	_, err := client.AddTeam(ctx, &reviewerpb.AddTeamRequest{Team: &reviewerpb.Team{
+		TeamName: "backend",
+		Members: []*reviewerpb.TeamMember{
+			{UserId: "u1", Username: "Alice", IsActive: true},
+			{UserId: "u2", Username: "Bob", IsActive: true},
+			{UserId: "u3", Username: "Carol", IsActive: false},
+		},
+	}})
+	require.NoError(t, err)
+	for _, id := range []string{"pr-1", "pr-2"} {
+		_, err = client.CreatePullRequest(ctx, &reviewerpb.CreatePullRequestRequest{PullRequestId: id, PullRequestName: id, AuthorId: "u1"})
+		require.NoError(t, err)
+	}
+	resp, err := client.SetIsActive(ctx, &reviewerpb.SetIsActiveRequest{UserId: "u3", IsActive: true})
+	require.NoError(t, err)
+	require.NotNil(t, resp.User)
+	assert.Equal(t, "u3", resp.User.UserId)
+	assert.Equal(t, "Carol", resp.User.Username)
+	assert.Equal(t, "backend", resp.User.TeamName)
+	assert.True(t, resp.User.IsActive)
+
+	plan, err := client.RebalanceTeam(ctx, &reviewerpb.RebalanceTeamRequest{TeamName: "backend", DryRun: true})
+	require.NoError(t, err)
+	assert.True(t, plan.DryRun)
+	require.Len(t, plan.Moves, 1)
+	assert.Equal(t, "u2", plan.Moves[0].OldReviewerId)
+	assert.Equal(t, "u3", plan.Moves[0].NewReviewerId)
+	review, err := client.GetReview(ctx, &reviewerpb.GetReviewRequest{UserId: "u3"})
+	require.NoError(t, err)
+	assert.Empty(t, review.PullRequests)
+
+	report, err := client.RebalanceTeam(ctx, &reviewerpb.RebalanceTeamRequest{TeamName: "backend"})
+	require.NoError(t, err)
+	assert.False(t, report.DryRun)
+	require.Len(t, report.Moves, 1)
+	assert.Len(t, report.Loads, 3)
+	review, err = client.GetReview(ctx, &reviewerpb.GetReviewRequest{UserId: "u3"})
+	require.NoError(t, err)
+	require.Len(t, review.PullRequests, 1)
+	assert.Equal(t, report.Moves[0].PullRequestId, review.PullRequests[0].PullRequestId)
+
+	_, err = client.RebalanceTeam(ctx, &reviewerpb.RebalanceTeamRequest{TeamName: "unknown"})
+	code, reason := errorReason(t, err)
+	assert.Equal(t, codes.NotFound, code)
+	assert.Equal(t, "NOT_FOUND", reason)
+}
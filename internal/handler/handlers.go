@@ -2,28 +2,35 @@ package handler
 
 import (
 	"avito-internship/api"
+	"avito-internship/internal/health"
 	"avito-internship/internal/models"
 	"avito-internship/internal/service"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 )
 
 type Handlers struct {
-	service *service.Service
+	service         *service.Service
+	readinessChecks []health.Check
+	forgeSecrets    ForgeSecrets
 }
 
-func NewHandlers(service *service.Service) *Handlers {
-	return &Handlers{service: service}
+func NewHandlers(service *service.Service, readinessChecks ...health.Check) *Handlers {
+	return &Handlers{service: service, readinessChecks: readinessChecks}
+}
+
+// WithForgeSecrets включает приём вебхуков GitHub и GitLab, для которых задан секрет
+func (h *Handlers) WithForgeSecrets(secrets ForgeSecrets) *Handlers {
+	h.forgeSecrets = secrets
+	return h
 }
 
 func (h *Handlers) PostTeamAdd(ctx echo.Context) error {
 	var req api.PostTeamAddJSONRequestBody
 	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: "invalid request"}})
+		return err
 	}
 	members := make([]models.TeamMember, len(req.Members))
 	for i, m := range req.Members {
@@ -33,12 +40,9 @@ func (h *Handlers) PostTeamAdd(ctx echo.Context) error {
 			IsActive: m.IsActive,
 		}
 	}
-	err := h.service.AddTeam(req.TeamName, members)
+	err := h.as(ctx).AddTeam(req.TeamName, members)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.TEAMEXISTS, Message: err.Error()}})
+		return err
 	}
 	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
 }
@@ -46,10 +50,7 @@ func (h *Handlers) PostTeamAdd(ctx echo.Context) error {
 func (h *Handlers) GetTeamGet(ctx echo.Context, params api.GetTeamGetParams) error {
 	members, err := h.service.GetTeam(params.TeamName)
 	if err != nil {
-		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: err.Error()}})
+		return err
 	}
 	resp := api.Team{
 		TeamName: params.TeamName,
@@ -68,129 +69,98 @@ func (h *Handlers) GetTeamGet(ctx echo.Context, params api.GetTeamGetParams) err
 func (h *Handlers) PostUsersSetIsActive(ctx echo.Context) error {
 	var req api.PostUsersSetIsActiveJSONRequestBody
 	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: "invalid request"}})
+		return err
 	}
-	err := h.service.SetUserActive(req.UserId, req.IsActive)
+	user, report, err := h.as(ctx).SetUserActive(req.UserId, req.IsActive, req.DryRun != nil && *req.DryRun,
+		req.Rebalance != nil && *req.Rebalance)
 	if err != nil {
-		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: err.Error()}})
+		return err
 	}
-	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
+	resp := struct {
+		User   api.User               `json:"user"`
+		Report api.DeactivationReport `json:"report"`
+	}{
+		User:   userToAPI(user),
+		Report: deactivationReportToAPI(report),
+	}
+	return ctx.JSON(http.StatusOK, resp)
 }
 
 func (h *Handlers) PostPullRequestCreate(ctx echo.Context) error {
 	var req api.PostPullRequestCreateJSONRequestBody
 	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: "invalid request"}})
+		return err
 	}
-	pr, err := h.service.CreatePR(req.PullRequestId, req.PullRequestName, req.AuthorId)
-	if err != nil {
-		if err.Error() == "user not found" {
-			return ctx.JSON(http.StatusNotFound, api.ErrorResponse{Error: struct {
-				Code    api.ErrorResponseErrorCode `json:"code"`
-				Message string                     `json:"message"`
-			}{Code: api.NOTFOUND, Message: err.Error()}})
-		}
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.PREXISTS, Message: err.Error()}})
+	var changedFiles []string
+	if req.ChangedFiles != nil {
+		changedFiles = *req.ChangedFiles
 	}
-	resp := api.PullRequest{
-		PullRequestId:     pr.PullRequestId,
-		PullRequestName:   pr.PullRequestName,
-		AuthorId:          pr.AuthorId,
-		Status:            api.PullRequestStatus(pr.Status),
-		AssignedReviewers: pr.AssignedReviewers,
-		CreatedAt:         pr.CreatedAt,
-		MergedAt:          pr.MergedAt,
+	pr, err := h.as(ctx).CreatePR(req.PullRequestId, req.PullRequestName, req.AuthorId, req.ReviewerCount, changedFiles)
+	if err != nil {
+		return err
 	}
-	return ctx.JSON(http.StatusOK, resp)
+	return ctx.JSON(http.StatusOK, pullRequestToAPI(pr))
 }
 
 func (h *Handlers) PostPullRequestMerge(ctx echo.Context) error {
 	var req api.PostPullRequestMergeJSONRequestBody
 	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: "invalid request"}})
+		return err
 	}
-	pr, err := h.service.MergePR(req.PullRequestId)
+	pr, err := h.as(ctx).MergePR(req.PullRequestId, req.Force != nil && *req.Force)
 	if err != nil {
-		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: err.Error()}})
-	}
-	resp := api.PullRequest{
-		PullRequestId:     pr.PullRequestId,
-		PullRequestName:   pr.PullRequestName,
-		AuthorId:          pr.AuthorId,
-		Status:            api.PullRequestStatus(pr.Status),
-		AssignedReviewers: pr.AssignedReviewers,
-		CreatedAt:         pr.CreatedAt,
-		MergedAt:          pr.MergedAt,
+		return err
 	}
-	return ctx.JSON(http.StatusOK, resp)
+	return ctx.JSON(http.StatusOK, pullRequestToAPI(pr))
 }
 
 func (h *Handlers) PostPullRequestReassign(ctx echo.Context) error {
 	var req api.PostPullRequestReassignJSONRequestBody
 	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: "invalid request"}})
-	}
-	pr, newReviewer, err := h.service.ReassignPR(req.PullRequestId, req.OldUserId)
-	if err != nil {
-		code := api.NOTFOUND
-		if err.Error() == "cannot reassign on merged PR" {
-			code = api.PRMERGED
-		} else if err.Error() == "reviewer is not assigned to this PR" {
-			code = api.NOTASSIGNED
-		} else if err.Error() == "no active replacement candidate in team" {
-			code = api.NOCANDIDATE
-		}
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: code, Message: err.Error()}})
+		return err
+	}
+	pr, newReviewer, err := h.as(ctx).ReassignPR(req.PullRequestId, req.OldUserId)
+	if err != nil {
+		return err
 	}
 	resp := struct {
 		Pr         api.PullRequest `json:"pr"`
 		ReplacedBy string          `json:"replaced_by"`
 	}{
-		Pr: api.PullRequest{
-			PullRequestId:     pr.PullRequestId,
-			PullRequestName:   pr.PullRequestName,
-			AuthorId:          pr.AuthorId,
-			Status:            api.PullRequestStatus(pr.Status),
-			AssignedReviewers: pr.AssignedReviewers,
-			CreatedAt:         pr.CreatedAt,
-			MergedAt:          pr.MergedAt,
-		},
+		Pr:         pullRequestToAPI(pr),
 		ReplacedBy: newReviewer,
 	}
 	return ctx.JSON(http.StatusOK, resp)
 }
 
+func (h *Handlers) GetPullRequestGet(ctx echo.Context, params api.GetPullRequestGetParams) error {
+	details, err := h.service.GetPRDetails(params.PullRequestId)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, pullRequestDetailToAPI(details))
+}
+
+func (h *Handlers) PostPullRequestReview(ctx echo.Context) error {
+	var req api.PostPullRequestReviewJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	body := ""
+	if req.Body != nil {
+		body = *req.Body
+	}
+	details, err := h.as(ctx).SubmitReview(req.PullRequestId, req.ReviewerId, string(req.State), body)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, pullRequestDetailToAPI(details))
+}
+
 func (h *Handlers) GetUsersGetReview(ctx echo.Context, params api.GetUsersGetReviewParams) error {
 	prs, err := h.service.GetPRsByReviewer(params.UserId)
 	if err != nil {
-		return ctx.JSON(http.StatusNotFound, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: err.Error()}})
+		return err
 	}
 	resp := struct {
 		UserId       string                 `json:"user_id"`
@@ -213,30 +183,333 @@ func (h *Handlers) GetUsersGetReview(ctx echo.Context, params api.GetUsersGetRev
 func (h *Handlers) GetStatsAssignments(ctx echo.Context) error {
 	stats, err := h.service.GetAssignmentStats()
 	if err != nil {
-		return ctx.JSON(http.StatusInternalServerError, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: err.Error()}})
+		return err
 	}
 	return ctx.JSON(http.StatusOK, stats)
 }
 
+func (h *Handlers) GetStatsLoad(ctx echo.Context) error {
+	loads, err := h.service.GetReviewerLoads()
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		Users []api.ReviewerLoad `json:"users"`
+	}{
+		Users: make([]api.ReviewerLoad, len(loads)),
+	}
+	for i, load := range loads {
+		resp.Users[i] = api.ReviewerLoad{
+			UserId:         load.UserId,
+			TeamName:       load.TeamName,
+			OpenReviews:    load.OpenReviews,
+			MaxOpenReviews: load.MaxOpenReviews,
+			AtCapacity:     load.MaxOpenReviews > 0 && load.OpenReviews >= load.MaxOpenReviews,
+		}
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
 func (h *Handlers) PostTeamDeactivate(ctx echo.Context) error {
-	var req struct {
-		TeamName string `json:"team_name"`
+	var req api.PostTeamDeactivateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
 	}
+	report, err := h.as(ctx).DeactivateTeam(req.TeamName, req.DryRun != nil && *req.DryRun)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, deactivationReportToAPI(report))
+}
+
+func (h *Handlers) PostTeamRebalance(ctx echo.Context) error {
+	var req api.PostTeamRebalanceJSONRequestBody
 	if err := ctx.Bind(&req); err != nil {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: "invalid request"}})
+		return err
+	}
+	report, err := h.as(ctx).RebalanceTeam(req.TeamName, req.DryRun != nil && *req.DryRun)
+	if err != nil {
+		return err
 	}
-	err := h.service.DeactivateTeam(req.TeamName)
+	return ctx.JSON(http.StatusOK, rebalanceReportToAPI(report))
+}
+
+func (h *Handlers) GetTeamGetSettings(ctx echo.Context, params api.GetTeamGetSettingsParams) error {
+	settings, err := h.service.GetTeamSettings(params.TeamName)
 	if err != nil {
-		return ctx.JSON(http.StatusBadRequest, api.ErrorResponse{Error: struct {
-			Code    api.ErrorResponseErrorCode `json:"code"`
-			Message string                     `json:"message"`
-		}{Code: api.NOTFOUND, Message: err.Error()}})
+		return err
 	}
+	return ctx.JSON(http.StatusOK, teamSettingsToAPI(settings))
+}
+
+func (h *Handlers) PostTeamSetSettings(ctx echo.Context) error {
+	var req api.PostTeamSetSettingsJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	settings, err := h.as(ctx).SetTeamSettings(req.TeamName, func(settings *models.TeamSettings) {
+		if req.ReviewerStrategy != nil {
+			settings.ReviewerStrategy = string(*req.ReviewerStrategy)
+		}
+		if req.MinApprovals != nil {
+			settings.MinApprovals = *req.MinApprovals
+		}
+		if req.RequireLeadApproval != nil {
+			settings.RequireLeadApproval = *req.RequireLeadApproval
+		}
+		if req.MinReviewers != nil {
+			settings.MinReviewers = *req.MinReviewers
+		}
+		if req.MaxReviewers != nil {
+			settings.MaxReviewers = *req.MaxReviewers
+		}
+		if req.MaxOpenReviews != nil {
+			settings.MaxOpenReviews = *req.MaxOpenReviews
+		}
+		if req.ReviewSlaMinutes != nil {
+			settings.ReviewSLA = time.Duration(*req.ReviewSlaMinutes) * time.Minute
+		}
+		if req.ReassignAfterMinutes != nil {
+			settings.ReassignAfter = time.Duration(*req.ReassignAfterMinutes) * time.Minute
+		}
+		if req.FallbackTeams != nil {
+			settings.FallbackTeams = *req.FallbackTeams
+		}
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, teamSettingsToAPI(settings))
+}
+
+func (h *Handlers) PostUsersSetSettings(ctx echo.Context) error {
+	var req api.PostUsersSetSettingsJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	user, err := h.as(ctx).SetUserSettings(req.UserId, func(user *models.User) {
+		if req.ReviewWeight != nil {
+			user.ReviewWeight = *req.ReviewWeight
+		}
+		if req.IsLead != nil {
+			user.IsLead = *req.IsLead
+		}
+		if req.MaxOpenReviews != nil {
+			user.MaxOpenReviews = *req.MaxOpenReviews
+		}
+	})
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		User api.User `json:"user"`
+	}{
+		User: userToAPI(user),
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handlers) GetCodeOwnersList(ctx echo.Context) error {
+	rules, err := h.service.GetCodeOwnerRules()
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		Rules []api.CodeOwnerRule `json:"rules"`
+	}{
+		Rules: make([]api.CodeOwnerRule, len(rules)),
+	}
+	for i, rule := range rules {
+		resp.Rules[i] = codeOwnerRuleToAPI(rule)
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handlers) PostCodeOwnersSet(ctx echo.Context) error {
+	var req api.PostCodeOwnersSetJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	rule := models.CodeOwnerRule{Pattern: req.Pattern}
+	if req.Users != nil {
+		rule.Users = *req.Users
+	}
+	if req.Teams != nil {
+		rule.Teams = *req.Teams
+	}
+	rule, err := h.as(ctx).SaveCodeOwnerRule(rule)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, codeOwnerRuleToAPI(rule))
+}
+
+func (h *Handlers) PostCodeOwnersDelete(ctx echo.Context) error {
+	var req api.PostCodeOwnersDeleteJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.as(ctx).DeleteCodeOwnerRule(req.Pattern); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (h *Handlers) GetHealthLive(ctx echo.Context) error {
 	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
 }
+
+func (h *Handlers) GetHealthReady(ctx echo.Context) error {
+	results, ready := health.RunChecks(ctx.Request().Context(), h.readinessChecks)
+	resp := api.HealthStatus{
+		Status: api.HealthStatusStatusOk,
+		Checks: make([]api.HealthCheck, len(results)),
+	}
+	for i, r := range results {
+		resp.Checks[i] = api.HealthCheck{Name: r.Name, Status: api.HealthCheckStatusOk}
+		if r.Err != nil {
+			message := r.Err.Error()
+			resp.Checks[i].Status = api.HealthCheckStatusFail
+			resp.Checks[i].Error = &message
+		}
+	}
+	if !ready {
+		resp.Status = api.HealthStatusStatusUnavailable
+		return ctx.JSON(http.StatusServiceUnavailable, resp)
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func userToAPI(user models.User) api.User {
+	return api.User{
+		UserId:         user.UserId,
+		Username:       user.Username,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		ReviewWeight:   &user.ReviewWeight,
+		IsLead:         &user.IsLead,
+		MaxOpenReviews: &user.MaxOpenReviews,
+	}
+}
+
+func pullRequestToAPI(pr models.PullRequest) api.PullRequest {
+	resp := api.PullRequest{
+		PullRequestId:     pr.PullRequestId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorId,
+		Status:            api.PullRequestStatus(pr.Status),
+		AssignedReviewers: pr.AssignedReviewers,
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+		ForceMerged:       &pr.ForceMerged,
+		UnderReviewed:     &pr.UnderReviewed,
+	}
+	if pr.ReviewerTeams != nil {
+		resp.ReviewerTeams = &pr.ReviewerTeams
+	}
+	return resp
+}
+
+func deactivationReportToAPI(report models.DeactivationReport) api.DeactivationReport {
+	resp := api.DeactivationReport{
+		TeamName:         report.TeamName,
+		DryRun:           report.DryRun,
+		DeactivatedUsers: append([]string{}, report.DeactivatedUsers...),
+		Reassignments:    []api.Reassignment{},
+		UnderReviewed:    []api.UnderReviewedPullRequest{},
+	}
+	for _, r := range report.Reassignments {
+		resp.Reassignments = append(resp.Reassignments, reassignmentToAPI(r))
+	}
+	for _, pr := range report.UnderReviewed {
+		resp.UnderReviewed = append(resp.UnderReviewed, api.UnderReviewedPullRequest{
+			PullRequestId:   pr.PullRequestId,
+			ActiveReviewers: append([]string{}, pr.ActiveReviewers...),
+			MinReviewers:    pr.MinReviewers,
+		})
+	}
+	if report.Rebalance != nil {
+		rebalance := rebalanceReportToAPI(*report.Rebalance)
+		resp.Rebalance = &rebalance
+	}
+	return resp
+}
+
+func rebalanceReportToAPI(report models.RebalanceReport) api.RebalanceReport {
+	resp := api.RebalanceReport{
+		TeamName: report.TeamName,
+		DryRun:   report.DryRun,
+		Moves:    []api.Reassignment{},
+		Loads:    []api.MemberLoad{},
+	}
+	for _, m := range report.Moves {
+		resp.Moves = append(resp.Moves, reassignmentToAPI(m))
+	}
+	for _, l := range report.Loads {
+		resp.Loads = append(resp.Loads, api.MemberLoad{UserId: l.UserId, Before: l.Before, After: l.After})
+	}
+	return resp
+}
+
+func reassignmentToAPI(r models.Reassignment) api.Reassignment {
+	item := api.Reassignment{PullRequestId: r.PullRequestId, OldReviewerId: r.OldReviewerId}
+	if r.NewReviewerId != "" {
+		item.NewReviewerId = &r.NewReviewerId
+	}
+	if r.Reason != "" {
+		item.Reason = &r.Reason
+	}
+	return item
+}
+
+func pullRequestDetailToAPI(details models.PullRequestDetails) api.PullRequestDetail {
+	resp := api.PullRequestDetail{
+		Pr:        pullRequestToAPI(details.PullRequest),
+		Reviewers: make([]api.ReviewerState, len(details.Reviewers)),
+		Reviews:   make([]api.Review, len(details.Reviews)),
+	}
+	for i, r := range details.Reviewers {
+		resp.Reviewers[i] = api.ReviewerState{
+			UserId:      r.UserId,
+			State:       api.ReviewerStateState(r.State),
+			SubmittedAt: r.SubmittedAt,
+		}
+	}
+	for i, r := range details.Reviews {
+		resp.Reviews[i] = api.Review{
+			ReviewerId:  r.ReviewerId,
+			State:       api.ReviewState(r.State),
+			Body:        r.Body,
+			SubmittedAt: r.SubmittedAt,
+		}
+	}
+	return resp
+}
+
+func codeOwnerRuleToAPI(rule models.CodeOwnerRule) api.CodeOwnerRule {
+	users := append([]string{}, rule.Users...)
+	teams := append([]string{}, rule.Teams...)
+	return api.CodeOwnerRule{Pattern: rule.Pattern, Users: &users, Teams: &teams}
+}
+
+func teamSettingsToAPI(settings models.TeamSettings) api.TeamSettings {
+	fallbackTeams := append([]string{}, settings.FallbackTeams...)
+	slaMinutes := int(settings.ReviewSLA / time.Minute)
+	reassignMinutes := int(settings.ReassignAfter / time.Minute)
+	resp := api.TeamSettings{
+		TeamName:             settings.TeamName,
+		MinApprovals:         &settings.MinApprovals,
+		RequireLeadApproval:  &settings.RequireLeadApproval,
+		MinReviewers:         &settings.MinReviewers,
+		MaxReviewers:         &settings.MaxReviewers,
+		MaxOpenReviews:       &settings.MaxOpenReviews,
+		ReviewSlaMinutes:     &slaMinutes,
+		ReassignAfterMinutes: &reassignMinutes,
+		FallbackTeams:        &fallbackTeams,
+	}
+	if settings.ReviewerStrategy != "" {
+		strategy := api.TeamSettingsReviewerStrategy(settings.ReviewerStrategy)
+		resp.ReviewerStrategy = &strategy
+	}
+	return resp
+}
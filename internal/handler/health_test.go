@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/health"
+	"avito-internship/internal/service"
+	"avito-internship/internal/storage"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHealthEndpoints(t *testing.T) {
+	dbErr := errors.New("connection refused")
+	e := echo.New()
+	e.HTTPErrorHandler = ErrorHandler
+	api.RegisterHandlers(e, NewHandlers(service.NewService(storage.NewInMemStorage()),
+		health.Check{Name: "config", Run: func(context.Context) error { return nil }},
+		health.Check{Name: "database", Run: func(context.Context) error { return dbErr }},
+	))
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	assert.Equal(t, http.StatusOK, get("/health/live").Code)
+
+	rec := get("/health/ready")
+	assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+	var resp api.HealthStatus
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	assert.Equal(t, api.HealthStatusStatusUnavailable, resp.Status)
+	require.Len(t, resp.Checks, 2)
+	assert.Equal(t, api.HealthCheckStatusOk, resp.Checks[0].Status)
+	assert.Equal(t, api.HealthCheckStatusFail, resp.Checks[1].Status)
+	assert.Equal(t, "connection refused", *resp.Checks[1].Error)
+
+	dbErr = nil
+	rec = get("/health/ready")
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
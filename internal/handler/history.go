@@ -0,0 +1,57 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/models"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handlers) GetPullRequestHistory(ctx echo.Context, params api.GetPullRequestHistoryParams) error {
+	history, err := h.service.GetPRHistory(params.PullRequestId)
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		PullRequestId string                 `json:"pull_request_id"`
+		Events        []api.PullRequestEvent `json:"events"`
+		State         api.PullRequest        `json:"state"`
+	}{
+		PullRequestId: params.PullRequestId,
+		Events:        make([]api.PullRequestEvent, len(history.Events)),
+		State:         pullRequestToAPI(history.State),
+	}
+	// under_reviewed не входит в историю
+	resp.State.UnderReviewed = nil
+	for i, e := range history.Events {
+		resp.Events[i] = prEventToAPI(i+1, e)
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func prEventToAPI(seq int, e models.PullRequestEvent) api.PullRequestEvent {
+	event := api.PullRequestEvent{
+		Seq:        seq,
+		Type:       api.PullRequestEventType(e.Type),
+		OccurredAt: e.OccurredAt,
+		Actor:      e.Actor,
+	}
+	if e.UserId != "" {
+		event.UserId = &e.UserId
+	}
+	if e.Reason != "" {
+		reason := api.PullRequestEventReason(e.Reason)
+		event.Reason = &reason
+	}
+	switch e.Type {
+	case models.PREventCreated:
+		event.PullRequestName = &e.PullRequestName
+	case models.PREventReviewSubmitted:
+		state := api.PullRequestEventReviewState(e.ReviewState)
+		event.ReviewState = &state
+	case models.PREventMerged:
+		event.ForceMerged = &e.ForceMerged
+	}
+	return event
+}
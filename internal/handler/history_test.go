@@ -0,0 +1,54 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/storage"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPullRequestHistory(t *testing.T) {
+	e := newTestEcho(t, storage.NewInMemStorage())
+
+	rec := postJSON(e, "/team/add", `{"team_name":"backend","members":[
+		{"user_id":"u1","username":"Alice","is_active":true},
+		{"user_id":"u2","username":"Bob","is_active":true}]}`)
+	require.Equal(t, http.StatusOK, rec.Code)
+	rec = postJSON(e, "/pullRequest/create", `{"pull_request_id":"pr1","pull_request_name":"Feature","author_id":"u1"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = postJSON(e, "/pullRequest/review", `{"pull_request_id":"pr1","reviewer_id":"u2","state":"APPROVED"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	rec = postJSON(e, "/pullRequest/merge", `{"pull_request_id":"pr1"}`)
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pullRequest/history?pull_request_id=pr1", nil))
+	require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+	var resp struct {
+		Events []api.PullRequestEvent `json:"events"`
+		State  api.PullRequest        `json:"state"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+	require.Len(t, resp.Events, 4)
+	for i, ev := range resp.Events {
+		assert.Equal(t, i+1, ev.Seq)
+	}
+	assert.Equal(t, api.PullRequestEventTypeCreated, resp.Events[0].Type)
+	assert.Equal(t, api.PullRequestEventTypeReviewerAssigned, resp.Events[1].Type)
+	require.NotNil(t, resp.Events[2].ReviewState)
+	assert.Equal(t, api.PullRequestEventReviewStateAPPROVED, *resp.Events[2].ReviewState)
+	require.NotNil(t, resp.Events[3].ForceMerged)
+	assert.False(t, *resp.Events[3].ForceMerged)
+	assert.Equal(t, api.PullRequestStatusMERGED, resp.State.Status)
+	assert.Equal(t, []string{"u2"}, resp.State.AssignedReviewers)
+	assert.Nil(t, resp.State.UnderReviewed)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/pullRequest/history?pull_request_id=missing", nil))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handlers) GetPullRequestOverdue(ctx echo.Context, params api.GetPullRequestOverdueParams) error {
+	var teamName string
+	if params.TeamName != nil {
+		teamName = *params.TeamName
+	}
+	overdue, err := h.service.GetOverduePRs(teamName)
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		PullRequests []api.OverduePullRequest `json:"pull_requests"`
+	}{
+		PullRequests: make([]api.OverduePullRequest, len(overdue)),
+	}
+	for i, o := range overdue {
+		item := api.OverduePullRequest{
+			PullRequestId:    o.PullRequest.PullRequestId,
+			PullRequestName:  o.PullRequest.PullRequestName,
+			AuthorId:         o.PullRequest.AuthorId,
+			Status:           api.OverduePullRequestStatus(o.PullRequest.Status),
+			TeamName:         o.TeamName,
+			OverdueReviewers: make([]api.OverdueReviewer, len(o.Reviewers)),
+		}
+		for j, r := range o.Reviewers {
+			item.OverdueReviewers[j] = api.OverdueReviewer{
+				UserId:     r.UserId,
+				AssignedAt: r.AssignedAt,
+				DueAt:      r.DueAt,
+				ReassignAt: r.ReassignAt,
+			}
+		}
+		resp.PullRequests[i] = item
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,131 @@
+package handler
+
+import (
+	"avito-internship/api"
+	"avito-internship/internal/models"
+	"encoding/json"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h *Handlers) PostWebhooksCreate(ctx echo.Context) error {
+	var req api.PostWebhooksCreateJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	events := make([]string, len(req.Events))
+	for i, e := range req.Events {
+		events[i] = string(e)
+	}
+	webhook, err := h.as(ctx).CreateWebhook(req.Url, events, req.Secret)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, webhookToAPI(webhook))
+}
+
+func (h *Handlers) GetWebhooksList(ctx echo.Context) error {
+	webhooks, err := h.service.GetWebhooks()
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		Webhooks []api.Webhook `json:"webhooks"`
+	}{
+		Webhooks: make([]api.Webhook, len(webhooks)),
+	}
+	for i, w := range webhooks {
+		resp.Webhooks[i] = webhookToAPI(w)
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handlers) PostWebhooksDelete(ctx echo.Context) error {
+	var req api.PostWebhooksDeleteJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	if err := h.as(ctx).DeleteWebhook(req.WebhookId); err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (h *Handlers) GetWebhooksDeliveries(ctx echo.Context, params api.GetWebhooksDeliveriesParams) error {
+	var filter models.DeliveryFilter
+	if params.WebhookId != nil {
+		filter.WebhookId = *params.WebhookId
+	}
+	if params.Status != nil {
+		filter.Status = string(*params.Status)
+	}
+	if params.Limit != nil {
+		filter.Limit = *params.Limit
+	}
+	deliveries, err := h.service.GetDeliveries(filter)
+	if err != nil {
+		return err
+	}
+	resp := struct {
+		Deliveries []api.WebhookDelivery `json:"deliveries"`
+	}{
+		Deliveries: make([]api.WebhookDelivery, len(deliveries)),
+	}
+	for i, d := range deliveries {
+		if resp.Deliveries[i], err = deliveryToAPI(d); err != nil {
+			return err
+		}
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func (h *Handlers) PostWebhooksReplay(ctx echo.Context) error {
+	var req api.PostWebhooksReplayJSONRequestBody
+	if err := ctx.Bind(&req); err != nil {
+		return err
+	}
+	delivery, err := h.as(ctx).ReplayDelivery(req.DeliveryId)
+	if err != nil {
+		return err
+	}
+	resp, err := deliveryToAPI(delivery)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(http.StatusOK, resp)
+}
+
+func webhookToAPI(webhook models.WebhookSubscription) api.Webhook {
+	events := make([]api.WebhookEvent, len(webhook.Events))
+	for i, e := range webhook.Events {
+		events[i] = api.WebhookEvent(e)
+	}
+	return api.Webhook{
+		WebhookId: webhook.Id,
+		Url:       webhook.URL,
+		Events:    events,
+		CreatedAt: webhook.CreatedAt,
+	}
+}
+
+func deliveryToAPI(delivery models.WebhookDelivery) (api.WebhookDelivery, error) {
+	var payload map[string]interface{}
+	if err := json.Unmarshal(delivery.Payload, &payload); err != nil {
+		return api.WebhookDelivery{}, err
+	}
+	return api.WebhookDelivery{
+		DeliveryId:     delivery.Id,
+		WebhookId:      delivery.WebhookId,
+		EventId:        delivery.EventId,
+		Event:          api.WebhookEvent(delivery.Event),
+		Payload:        payload,
+		Status:         api.WebhookDeliveryStatus(delivery.Status),
+		Attempts:       delivery.Attempts,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		LastError:      delivery.LastError,
+		ResponseStatus: delivery.ResponseStatus,
+		CreatedAt:      delivery.CreatedAt,
+		DeliveredAt:    delivery.DeliveredAt,
+	}, nil
+}
@@ -0,0 +1,57 @@
+package health
+
+import (
+	"avito-internship/internal/config"
+	"avito-internship/internal/service"
+	"avito-internship/migrations"
+	"context"
+	"database/sql"
+	"time"
+)
+
+const checkTimeout = 2 * time.Second
+
+// Check проверяет одну зависимость, без которой сервис не может обслуживать запросы
+type Check struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+type Result struct {
+	Name string
+	Err  error
+}
+
+// RunChecks выполняет все проверки, ограничивая каждую таймаутом, чтобы зависшая БД не блокировала readiness
+func RunChecks(ctx context.Context, checks []Check) ([]Result, bool) {
+	results := make([]Result, len(checks))
+	ready := true
+	for i, c := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+		err := c.Run(checkCtx)
+		cancel()
+		results[i] = Result{Name: c.Name, Err: err}
+		if err != nil {
+			ready = false
+		}
+	}
+	return results, ready
+}
+
+func Database(db *sql.DB) Check {
+	return Check{Name: "database", Run: db.PingContext}
+}
+
+func Migrations(checker *migrations.Checker) Check {
+	return Check{Name: "migrations", Run: checker.CheckUpToDate}
+}
+
+func Config(cfg *config.Config) Check {
+	return Check{Name: "config", Run: func(context.Context) error {
+		if err := cfg.Validate(); err != nil {
+			return err
+		}
+		_, err := service.StrategyByName(cfg.ReviewerStrategy)
+		return err
+	}}
+}
@@ -0,0 +1,36 @@
+package health
+
+import (
+	"avito-internship/internal/config"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunChecks(t *testing.T) {
+	db, mock, err := sqlmock.New(sqlmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	defer db.Close()
+
+	mock.ExpectPing()
+	mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+
+	cfg := &config.Config{HTTPPort: "8080", GRPCPort: "50051", StorageType: "postgres", ReviewerStrategy: "random", DBHost: "db", DBName: "reviews", AbsenceCheckInterval: time.Minute, SLACheckInterval: time.Minute, WebhookInterval: time.Second, OutboxInterval: time.Second}
+	checks := []Check{Config(cfg), Database(db)}
+
+	results, ready := RunChecks(context.Background(), checks)
+	assert.True(t, ready)
+	assert.Equal(t, []Result{{Name: "config"}, {Name: "database"}}, results)
+
+	cfg.ReviewerStrategy = "fastest"
+	results, ready = RunChecks(context.Background(), checks)
+	assert.False(t, ready)
+	assert.EqualError(t, results[0].Err, `unknown reviewer strategy "fastest"`)
+	assert.EqualError(t, results[1].Err, "connection refused")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,177 @@
+package metrics
+
+import (
+	"avito-internship/internal/models"
+	"database/sql"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "reviewer"
+
+// StatsSource отдаёт текущее состояние PR, которое считывается при каждом scrape
+type StatsSource interface {
+	CountOpenPRsByTeam() (map[string]int, error)
+	GetReviewerLoads() ([]models.ReviewerLoad, error)
+}
+
+type Metrics struct {
+	registry *prometheus.Registry
+
+	httpRequests         *prometheus.CounterVec
+	httpDuration         *prometheus.HistogramVec
+	prsCreated           prometheus.Counter
+	prsMerged            prometheus.Counter
+	reassignments        *prometheus.CounterVec
+	deactivationDuration prometheus.Histogram
+	deactivationPRs      prometheus.Counter
+}
+
+func New(stats StatsSource) *Metrics {
+	m := &Metrics{
+		registry: prometheus.NewRegistry(),
+		httpRequests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "http_requests_total",
+			Help:      "HTTP requests by method, route and status code.",
+		}, []string{"method", "route", "status"}),
+		httpDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "http_request_duration_seconds",
+			Help:      "HTTP request latency by method and route.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "route"}),
+		prsCreated: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pull_requests_created_total",
+			Help:      "Pull requests created.",
+		}),
+		prsMerged: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "pull_requests_merged_total",
+			Help:      "Pull requests merged.",
+		}),
+		reassignments: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "reassignments_total",
+			Help:      "Reviewer reassignments by outcome: success or the API error code.",
+		}, []string{"outcome"}),
+		deactivationDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Namespace: namespace,
+			Name:      "team_deactivation_duration_seconds",
+			Help:      "Duration of team deactivation including reassignment of open reviews.",
+			Buckets:   []float64{.01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30},
+		}),
+		deactivationPRs: prometheus.NewCounter(prometheus.CounterOpts{
+			Namespace: namespace,
+			Name:      "team_deactivation_reassigned_total",
+			Help:      "Open reviews reassigned while deactivating teams.",
+		}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.httpRequests,
+		m.httpDuration,
+		m.prsCreated,
+		m.prsMerged,
+		m.reassignments,
+		m.deactivationDuration,
+		m.deactivationPRs,
+		&statsCollector{stats: stats},
+	)
+	return m
+}
+
+// RegisterDB экспортирует статистику пула соединений sql.DB
+func (m *Metrics) RegisterDB(db *sql.DB, dbName string) {
+	m.registry.MustRegister(collectors.NewDBStatsCollector(db, dbName))
+}
+
+// Handler продолжает отдавать остальные метрики, даже если БД недоступна и статистика PR не собралась
+func (m *Metrics) Handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{ErrorHandling: promhttp.ContinueOnError})
+}
+
+// Middleware считает запросы по шаблону маршрута, чтобы id в query не раздували число серий
+func (m *Metrics) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+			if err != nil {
+				// Отдаём ошибку обработчику сразу, иначе статус ответа ещё не известен
+				c.Error(err)
+			}
+			route := c.Path()
+			if route == "" {
+				route = "unmatched"
+			}
+			method := c.Request().Method
+			m.httpRequests.WithLabelValues(method, route, strconv.Itoa(c.Response().Status)).Inc()
+			m.httpDuration.WithLabelValues(method, route).Observe(time.Since(start).Seconds())
+			return nil
+		}
+	}
+}
+
+func (m *Metrics) PRCreated() {
+	m.prsCreated.Inc()
+}
+
+func (m *Metrics) PRMerged() {
+	m.prsMerged.Inc()
+}
+
+func (m *Metrics) Reassigned(outcome string) {
+	m.reassignments.WithLabelValues(outcome).Inc()
+}
+
+func (m *Metrics) TeamDeactivated(duration time.Duration, reassigned int) {
+	m.deactivationDuration.Observe(duration.Seconds())
+	m.deactivationPRs.Add(float64(reassigned))
+}
+
+var (
+	openPRsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "open_pull_requests"),
+		"Open pull requests by author team.",
+		[]string{"team"}, nil)
+	openReviewsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "open_reviews"),
+		"Open reviews assigned to each active user.",
+		[]string{"team", "reviewer"}, nil)
+)
+
+type statsCollector struct {
+	stats StatsSource
+}
+
+func (c *statsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- openPRsDesc
+	ch <- openReviewsDesc
+}
+
+func (c *statsCollector) Collect(ch chan<- prometheus.Metric) {
+	byTeam, err := c.stats.CountOpenPRsByTeam()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(openPRsDesc, err)
+	}
+	for team, count := range byTeam {
+		ch <- prometheus.MustNewConstMetric(openPRsDesc, prometheus.GaugeValue, float64(count), team)
+	}
+
+	loads, err := c.stats.GetReviewerLoads()
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(openReviewsDesc, err)
+	}
+	for _, load := range loads {
+		ch <- prometheus.MustNewConstMetric(openReviewsDesc, prometheus.GaugeValue, float64(load.OpenReviews), load.TeamName, load.UserId)
+	}
+}
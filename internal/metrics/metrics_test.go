@@ -0,0 +1,95 @@
+package metrics
+
+import (
+	"avito-internship/internal/models"
+	"avito-internship/internal/storage"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMiddleware(t *testing.T) {
+	m := New(storage.NewInMemStorage())
+	e := echo.New()
+	e.Use(m.Middleware())
+	e.GET("/team/get", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	e.POST("/pullRequest/merge", func(c echo.Context) error { return echo.NewHTTPError(http.StatusNotFound) })
+
+	for _, req := range []*http.Request{
+		httptest.NewRequest(http.MethodGet, "/team/get?team_name=a", nil),
+		httptest.NewRequest(http.MethodGet, "/team/get?team_name=b", nil),
+		httptest.NewRequest(http.MethodPost, "/pullRequest/merge", nil),
+	} {
+		e.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.httpRequests.WithLabelValues(http.MethodGet, "/team/get", "200")))
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.httpRequests.WithLabelValues(http.MethodPost, "/pullRequest/merge", "404")))
+	assert.Equal(t, 2, testutil.CollectAndCount(m.httpDuration))
+}
+
+func TestDomainMetrics(t *testing.T) {
+	repo := storage.NewInMemStorage()
+	require.NoError(t, repo.AddTeam("backend", []models.TeamMember{
+		{UserId: "u1", Username: "Alice", IsActive: true},
+		{UserId: "u2", Username: "Bob", IsActive: true},
+		{UserId: "u3", Username: "Carol", IsActive: false},
+	}))
+	now := time.Now()
+	require.NoError(t, repo.CreatePR(models.PullRequest{
+		PullRequestId: "pr-1", PullRequestName: "PR", AuthorId: "u1", Status: "OPEN",
+		AssignedReviewers: []string{"u2"}, CreatedAt: &now,
+	}))
+
+	m := New(repo)
+	m.PRCreated()
+	m.PRMerged()
+	m.Reassigned("success")
+	m.Reassigned("NO_CANDIDATE")
+	m.Reassigned("NO_CANDIDATE")
+	m.TeamDeactivated(time.Second, 3)
+
+	assert.Equal(t, 1.0, testutil.ToFloat64(m.prsCreated))
+	assert.Equal(t, 2.0, testutil.ToFloat64(m.reassignments.WithLabelValues("NO_CANDIDATE")))
+	assert.Equal(t, 3.0, testutil.ToFloat64(m.deactivationPRs))
+
+	expected := `
+# HELP reviewer_open_pull_requests Open pull requests by author team.
+# TYPE reviewer_open_pull_requests gauge
+reviewer_open_pull_requests{team="backend"} 1
+# HELP reviewer_open_reviews Open reviews assigned to each active user.
+# TYPE reviewer_open_reviews gauge
+reviewer_open_reviews{reviewer="u1",team="backend"} 0
+reviewer_open_reviews{reviewer="u2",team="backend"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(m.registry, strings.NewReader(expected),
+		"reviewer_open_pull_requests", "reviewer_open_reviews"))
+}
+
+type failingStats struct{}
+
+func (failingStats) CountOpenPRsByTeam() (map[string]int, error) {
+	return nil, errors.New("connection refused")
+}
+
+func (failingStats) GetReviewerLoads() ([]models.ReviewerLoad, error) {
+	return nil, errors.New("connection refused")
+}
+
+func TestHandler_StatsError(t *testing.T) {
+	m := New(failingStats{})
+	m.PRCreated()
+	rec := httptest.NewRecorder()
+	m.Handler().ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), "reviewer_pull_requests_created_total 1")
+	assert.NotContains(t, rec.Body.String(), "reviewer_open_reviews")
+}
@@ -9,12 +9,67 @@ type TeamMember struct {
 }
 
 type User struct {
+	UserId       string
+	Username     string
+	TeamName     string
+	IsActive     bool
+	ReviewWeight int
+	IsLead       bool
+	// MaxOpenReviews переопределяет ограничение команды; 0 - использовать ограничение команды
+	MaxOpenReviews int
+}
+
+const (
+	AbsenceVacation  = "VACATION"
+	AbsenceSickLeave = "SICK_LEAVE"
+	AbsenceOther     = "OTHER"
+)
+
+// Absence - период [StartsAt, EndsAt), когда пользователь не может ревьюить
+type Absence struct {
+	Id       int64
 	UserId   string
-	Username string
-	TeamName string
-	IsActive bool
+	Kind     string
+	StartsAt time.Time
+	EndsAt   time.Time
+	// Processed означает, что открытые ревью пользователя уже переназначены
+	Processed bool
+}
+
+// WorkingHours - еженедельное рабочее время пользователя в его часовом поясе.
+// Weekdays в нумерации time.Weekday, Start и End в формате "15:04".
+type WorkingHours struct {
+	UserId   string
+	Timezone string
+	Weekdays []int
+	Start    string
+	End      string
 }
 
+type TeamSettings struct {
+	TeamName         string
+	ReviewerStrategy string
+	// Политика слияния: сколько одобрений нужно от текущих ревьюверов и нужно ли одобрение лида команды
+	MinApprovals        int
+	RequireLeadApproval bool
+	// Сколько ревьюверов назначать на PR; если кандидатов меньше MinReviewers, PR помечается UnderReviewed
+	MinReviewers int
+	MaxReviewers int
+	// MaxOpenReviews - сколько открытых ревью может быть у участника команды; 0 - без ограничения
+	MaxOpenReviews int
+	// ReviewSLA - за сколько ревьювер должен ответить на назначение; ReassignAfter - через сколько после назначения
+	// молчащего ревьювера заменяют автоматически. Нулевые значения отключают проверку.
+	ReviewSLA     time.Duration
+	ReassignAfter time.Duration
+	// FallbackTeams в порядке приоритета, из них добираются ревьюверы, когда в команде не хватает кандидатов
+	FallbackTeams []string
+	// Version увеличивается при каждом изменении настроек и защищает от потерянных обновлений
+	Version int
+}
+
+// DefaultMaxReviewers - число ревьюверов на PR для команды без собственных настроек
+const DefaultMaxReviewers = 2
+
 type PullRequest struct {
 	PullRequestId     string
 	PullRequestName   string
@@ -23,6 +78,64 @@ type PullRequest struct {
 	AssignedReviewers []string
 	CreatedAt         *time.Time
 	MergedAt          *time.Time
+	// ForceMerged отмечает слияние в обход политики слияния команды
+	ForceMerged bool
+	// UnderReviewed означает, что при создании команда не набрала минимальное число ревьюверов
+	UnderReviewed bool
+	Version       int
+	// ReviewerTeams - команда каждого назначенного ревьювера; заполняется сервисом при создании и переназначении
+	ReviewerTeams map[string]string
+}
+
+const RoleReviewer = "REVIEWER"
+
+type Assignment struct {
+	PullRequestId string
+	UserId        string
+	Role          string
+	AssignedAt    time.Time
+	UnassignedAt  *time.Time
+	// OverdueAt - когда фоновая проверка SLA отметила назначение просроченным
+	OverdueAt *time.Time
+	// ReassignFailedAt - когда автоматическая замена по SLA последний раз не нашла кандидата
+	ReassignFailedAt *time.Time
+}
+
+const (
+	ReviewApproved         = "APPROVED"
+	ReviewChangesRequested = "CHANGES_REQUESTED"
+	ReviewCommented        = "COMMENTED"
+	// ReviewPending означает, что назначенный ревьювер ещё не оставил ревью
+	ReviewPending = "PENDING"
+)
+
+type Review struct {
+	PullRequestId string
+	ReviewerId    string
+	State         string
+	Body          string
+	SubmittedAt   time.Time
+}
+
+// ReviewerState - текущий вердикт назначенного ревьювера по его последнему ревью
+type ReviewerState struct {
+	UserId      string
+	State       string
+	SubmittedAt *time.Time
+}
+
+type PullRequestDetails struct {
+	PullRequest
+	Reviewers []ReviewerState
+	Reviews   []Review
+}
+
+// CodeOwnerRule назначает владельцев файлам, подходящим под шаблон в стиле CODEOWNERS.
+// Для каждого файла действует последнее подходящее правило.
+type CodeOwnerRule struct {
+	Pattern string
+	Users   []string
+	Teams   []string
 }
 
 type PullRequestShort struct {
@@ -32,7 +145,261 @@ type PullRequestShort struct {
 	Status          string
 }
 
+// OverdueReviewer - назначение, на которое ревьювер не ответил в срок SLA команды автора
+type OverdueReviewer struct {
+	UserId     string
+	AssignedAt time.Time
+	DueAt      time.Time
+	// ReassignAt - когда ревьювера заменят автоматически; nil, если автоматическое переназначение выключено
+	ReassignAt *time.Time
+}
+
+type OverduePR struct {
+	PullRequest PullRequestShort
+	TeamName    string
+	Reviewers   []OverdueReviewer
+}
+
+// Reassignment - замена одного ревьювера; если замены не нашлось, NewReviewerId пуст, а Reason объясняет почему
+type Reassignment struct {
+	PullRequestId string
+	OldReviewerId string
+	NewReviewerId string
+	Reason        string
+}
+
+// UnderReviewedPR - открытый PR, у которого после деактивации активных ревьюверов меньше MinReviewers
+// команды автора или не осталось ни одного
+type UnderReviewedPR struct {
+	PullRequestId   string
+	ActiveReviewers []string
+	MinReviewers    int
+}
+
+// DeactivationReport описывает деактивацию пользователей и замены их ревьюверов.
+// При DryRun это план: изменения не сохранены.
+type DeactivationReport struct {
+	TeamName         string
+	DryRun           bool
+	DeactivatedUsers []string
+	Reassignments    []Reassignment
+	UnderReviewed    []UnderReviewedPR
+	// Rebalance - выравнивание нагрузки после активации, если оно было запрошено
+	Rebalance *RebalanceReport
+}
+
+// MemberLoad - открытые ревью участника команды до и после выравнивания
+type MemberLoad struct {
+	UserId string
+	Before int
+	After  int
+}
+
+// RebalanceReport описывает перенос ревью между участниками команды. При DryRun это план.
+type RebalanceReport struct {
+	TeamName string
+	DryRun   bool
+	Moves    []Reassignment
+	Loads    []MemberLoad
+}
+
 type AssignmentStat struct {
 	UserId string `json:"user_id"`
 	Count  int    `json:"count"`
 }
+
+type ReviewerLoad struct {
+	UserId      string `json:"user_id"`
+	TeamName    string `json:"team_name"`
+	OpenReviews int    `json:"open_reviews"`
+	// MaxOpenReviews - действующее ограничение пользователя с учётом настроек команды; 0 - без ограничения
+	MaxOpenReviews int `json:"max_open_reviews"`
+}
+
+const (
+	EventPRCreated            = "pr.created"
+	EventPRMerged             = "pr.merged"
+	EventPRReviewerReassigned = "pr.reviewer_reassigned"
+	EventUserDeactivated      = "user.deactivated"
+	EventUserSettingsUpdated  = "user.settings_updated"
+	EventTeamDeactivated      = "team.deactivated"
+)
+
+// WebhookSubscription - адрес, на который доставляются события из Events, подписанные Secret
+type WebhookSubscription struct {
+	Id        int64
+	URL       string
+	Events    []string
+	Secret    string
+	CreatedAt time.Time
+}
+
+const (
+	DeliveryPending   = "PENDING"
+	DeliveryDelivered = "DELIVERED"
+	DeliveryFailed    = "FAILED"
+)
+
+// WebhookDelivery - попытки доставить одно событие одной подписке
+type WebhookDelivery struct {
+	Id        int64
+	WebhookId int64
+	// EventId одинаков у всех доставок события и позволяет получателю отбросить повторы
+	EventId       string
+	Event         string
+	Payload       []byte
+	Status        string
+	Attempts      int
+	NextAttemptAt time.Time
+	LastError     string
+	// ResponseStatus - HTTP-статус последней попытки; 0, если ответа не было
+	ResponseStatus int
+	CreatedAt      time.Time
+	DeliveredAt    *time.Time
+}
+
+// DeliveryFilter отбирает записи журнала доставок; нулевые поля не ограничивают выборку
+type DeliveryFilter struct {
+	WebhookId int64
+	Status    string
+	Limit     int
+}
+
+// OutboxEvent - доменное событие, записанное в той же транзакции, что и изменение
+type OutboxEvent struct {
+	Id int64
+	// EventId совпадает с id события в вебхуках и позволяет получателю отбросить повторы
+	EventId    string
+	Event      string
+	Payload    []byte
+	OccurredAt time.Time
+}
+
+// ForgeIdentity связывает имя пользователя на GitHub или GitLab с пользователем сервиса
+type ForgeIdentity struct {
+	Forge    string
+	Username string
+	UserId   string
+	// AccountId - числовой id аккаунта на стороне forge; 0 - не задан. По нему ищется автор MR GitLab.
+	AccountId int64
+}
+
+const (
+	ForgeOutcomeCreated = "created"
+	ForgeOutcomeMerged  = "merged"
+	ForgeOutcomeIgnored = "ignored"
+)
+
+// ForgeEventResult - что сервис сделал с входящим событием GitHub или GitLab
+type ForgeEventResult struct {
+	Outcome       string
+	PullRequestId string
+	// Reason объясняет, почему событие пропущено
+	Reason string
+}
+
+// Причины изменений в журнале аудита
+const (
+	AuditReasonManual       = "manual"
+	AuditReasonDeactivation = "deactivation"
+	AuditReasonAbsence      = "absence"
+	AuditReasonSLA          = "sla"
+	AuditReasonForge        = "forge"
+	AuditReasonRebalance    = "rebalance"
+)
+
+const (
+	AuditTargetTeam          = "team"
+	AuditTargetUser          = "user"
+	AuditTargetPullRequest   = "pull_request"
+	AuditTargetCodeOwnerRule = "code_owner_rule"
+	AuditTargetWebhook       = "webhook"
+	AuditTargetForgeIdentity = "forge_identity"
+)
+
+const (
+	AuditTeamCreated          = "team.created"
+	AuditTeamSettingsUpdated  = "team.settings_updated"
+	AuditTeamDeactivated      = "team.deactivated"
+	AuditUserActivated        = "user.activated"
+	AuditUserDeactivated      = "user.deactivated"
+	AuditUserSettingsUpdated  = "user.settings_updated"
+	AuditAbsenceAdded         = "user.absence_added"
+	AuditAbsenceDeleted       = "user.absence_deleted"
+	AuditWorkingHoursSet      = "user.working_hours_set"
+	AuditWorkingHoursDeleted  = "user.working_hours_deleted"
+	AuditPRCreated            = "pr.created"
+	AuditPRMerged             = "pr.merged"
+	AuditPRReviewSubmitted    = "pr.review_submitted"
+	AuditPRReviewerReassigned = "pr.reviewer_reassigned"
+	AuditPRReassignFailed     = "pr.reassign_failed"
+	AuditPRReviewerOverdue    = "pr.reviewer_overdue"
+	AuditCodeOwnerRuleSaved   = "code_owner_rule.saved"
+	AuditCodeOwnerRuleDeleted = "code_owner_rule.deleted"
+	AuditWebhookCreated       = "webhook.created"
+	AuditWebhookDeleted       = "webhook.deleted"
+	AuditDeliveryReplayed     = "webhook.delivery_replayed"
+	AuditForgeIdentitySet     = "forge_identity.set"
+	AuditForgeIdentityDeleted = "forge_identity.deleted"
+)
+
+// AuditEntry - запись журнала аудита об одном изменении состояния. Записи только добавляются.
+type AuditEntry struct {
+	Id         int64
+	OccurredAt time.Time
+	// Actor - кто выполнил действие: заголовок X-Actor запроса, github или gitlab для их вебхуков, system для фоновых задач
+	Actor      string
+	Action     string
+	TargetType string
+	TargetId   string
+	// PullRequestId, UserIds и TeamName - затронутые PR, пользователи и команда, по ним фильтруется журнал
+	PullRequestId string
+	UserIds       []string
+	TeamName      string
+	Reason        string
+	// Error - почему действие не выполнено; пусто для выполненных изменений
+	Error string
+	// Before и After - JSON-снимки цели до и после изменения; nil, если цели не было до или не стало после
+	Before []byte
+	After  []byte
+}
+
+// AuditFilter отбирает записи журнала аудита; нулевые поля не ограничивают выборку. Интервал [From, To).
+type AuditFilter struct {
+	PullRequestId string
+	UserId        string
+	TeamName      string
+	From          time.Time
+	To            time.Time
+	Limit         int
+}
+
+const (
+	PREventCreated            = "created"
+	PREventReviewerAssigned   = "reviewer_assigned"
+	PREventReviewerUnassigned = "reviewer_unassigned"
+	PREventReviewSubmitted    = "review_submitted"
+	PREventMerged             = "merged"
+)
+
+// PullRequestEvent - событие истории PR. События только добавляются, и по ним восстанавливается состояние PR.
+type PullRequestEvent struct {
+	PullRequestId string
+	Type          string
+	OccurredAt    time.Time
+	Actor         string
+	// UserId - автор для created и ревьювер для остальных событий, кроме merged
+	UserId string
+	// Reason - причина назначения или снятия ревьювера из AuditReason*; пусто у событий, перенесённых из старых данных
+	Reason string
+	// PullRequestName заполняется у created, ReviewState - у review_submitted, ForceMerged - у merged
+	PullRequestName string
+	ReviewState     string
+	ForceMerged     bool
+}
+
+// PullRequestHistory - события PR в порядке возникновения и состояние PR, восстановленное по ним
+type PullRequestHistory struct {
+	Events []PullRequestEvent
+	State  PullRequest
+}
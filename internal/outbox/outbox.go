@@ -0,0 +1,136 @@
+package outbox
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+)
+
+const (
+	SinkLog  = "log"
+	SinkHTTP = "http"
+	SinkFile = "file"
+
+	// HeaderBatch - id первого и последнего события пакета через ".."
+	HeaderBatch = "X-Reviewer-Outbox-Batch"
+)
+
+// Message - событие из outbox. Payload - JSON-объект с полями id, event, occurred_at и data;
+// id в нём совпадает с Id и служит ключом дедупликации на стороне получателя.
+type Message struct {
+	Id      string
+	Event   string
+	Payload []byte
+}
+
+// Sink принимает пакет событий. Доставка at-least-once: если Publish вернул ошибку, весь пакет будет
+// передан повторно, в том числе события, которые sink успел принять.
+type Sink interface {
+	Name() string
+	Publish(ctx context.Context, messages []Message) error
+}
+
+// Logger - подмножество echo.Logger. Printf пишет в лог независимо от уровня логирования.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+type LogSink struct {
+	logger Logger
+}
+
+func NewLogSink(logger Logger) *LogSink {
+	return &LogSink{logger: logger}
+}
+
+func (s *LogSink) Name() string { return SinkLog }
+
+func (s *LogSink) Publish(_ context.Context, messages []Message) error {
+	for _, m := range messages {
+		s.logger.Printf("outbox %s %s: %s", m.Event, m.Id, m.Payload)
+	}
+	return nil
+}
+
+// HTTPSink отправляет пакет одним POST в формате NDJSON: по событию на строку
+type HTTPSink struct {
+	url    string
+	client *http.Client
+}
+
+func NewHTTPSink(url string, client *http.Client) *HTTPSink {
+	return &HTTPSink{url: url, client: client}
+}
+
+func (s *HTTPSink) Name() string { return SinkHTTP }
+
+// Publish считает ошибкой любой ответ вне 2xx
+func (s *HTTPSink) Publish(ctx context.Context, messages []Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(ndjson(messages)))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-ndjson")
+	req.Header.Set(HeaderBatch, messages[0].Id+".."+messages[len(messages)-1].Id)
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("outbox http sink responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// FileSink дописывает события в NDJSON-файл; используется в тестах и локальной отладке
+type FileSink struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSink(path string) *FileSink {
+	return &FileSink{path: path}
+}
+
+func (s *FileSink) Name() string { return SinkFile }
+
+func (s *FileSink) Publish(_ context.Context, messages []Message) error {
+	if len(messages) == 0 {
+		return nil
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(ndjson(messages)); err != nil {
+		f.Close()
+		return err
+	}
+	// Пакет считается переданным только после записи на диск
+	if err := f.Sync(); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func ndjson(messages []Message) []byte {
+	var buf bytes.Buffer
+	for _, m := range messages {
+		buf.Write(bytes.TrimSpace(m.Payload))
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes()
+}
@@ -0,0 +1,69 @@
+package outbox
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var batch = []Message{
+	{Id: "ev1", Event: "pr.created", Payload: []byte(`{"id":"ev1","event":"pr.created"}`)},
+	{Id: "ev2", Event: "pr.merged", Payload: []byte("{\"id\":\"ev2\",\"event\":\"pr.merged\"}\n")},
+}
+
+func TestFileSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "events.ndjson")
+	sink := NewFileSink(path)
+	require.NoError(t, sink.Publish(context.Background(), batch[:1]))
+	require.NoError(t, sink.Publish(context.Background(), batch[1:]))
+	require.NoError(t, sink.Publish(context.Background(), nil))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "{\"id\":\"ev1\",\"event\":\"pr.created\"}\n{\"id\":\"ev2\",\"event\":\"pr.merged\"}\n", string(data))
+
+	assert.Error(t, NewFileSink(filepath.Join(t.TempDir(), "missing", "events.ndjson")).Publish(context.Background(), batch))
+}
+
+func TestHTTPSink(t *testing.T) {
+	var gotBody []byte
+	var gotHeader http.Header
+	status := http.StatusAccepted
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotHeader = r.Header
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sink := NewHTTPSink(srv.URL, srv.Client())
+	require.NoError(t, sink.Publish(context.Background(), batch))
+	assert.Equal(t, "{\"id\":\"ev1\",\"event\":\"pr.created\"}\n{\"id\":\"ev2\",\"event\":\"pr.merged\"}\n", string(gotBody))
+	assert.Equal(t, "application/x-ndjson", gotHeader.Get("Content-Type"))
+	assert.Equal(t, "ev1..ev2", gotHeader.Get(HeaderBatch))
+
+	status = http.StatusBadGateway
+	assert.Error(t, sink.Publish(context.Background(), batch))
+}
+
+type printer struct {
+	lines []string
+}
+
+func (p *printer) Printf(format string, args ...interface{}) {
+	p.lines = append(p.lines, fmt.Sprintf(format, args...))
+}
+
+func TestLogSink(t *testing.T) {
+	logger := &printer{}
+	require.NoError(t, NewLogSink(logger).Publish(context.Background(), batch[:1]))
+	assert.Equal(t, []string{`outbox pr.created ev1: {"id":"ev1","event":"pr.created"}`}, logger.lines)
+}
@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"time"
+)
+
+// ErrConflict возвращается, когда PR или настройки команды изменены конкурентным запросом между чтением и записью
+var ErrConflict = errs.New(errs.ErrConflict, "concurrent modification, retry the request")
+
+type TeamRepository interface {
+	AddTeam(teamName string, members []models.TeamMember) error
+	GetTeam(teamName string) ([]models.TeamMember, error)
+	DeactivateTeam(teamName string) error
+	GetTeamSettings(teamName string) (models.TeamSettings, error)
+	// UpdateTeamSettings возвращает ErrConflict, если настройки изменились после чтения (Version не совпадает)
+	UpdateTeamSettings(settings models.TeamSettings) error
+	GetRoundRobinCursor(teamName string) (string, error)
+	SetRoundRobinCursor(teamName, userId string) error
+}
+
+type UserRepository interface {
+	GetUser(userId string) (models.User, error)
+	GetUsersByTeam(teamName string) ([]models.User, error)
+	SetUserActive(userId string, isActive bool) error
+	SetUserReviewWeight(userId string, weight int) error
+	SetUserLead(userId string, isLead bool) error
+	SetUserMaxOpenReviews(userId string, limit int) error
+}
+
+type PullRequestRepository interface {
+	CreatePR(pr models.PullRequest) error
+	GetPR(prId string) (models.PullRequest, error)
+	UpdatePR(pr models.PullRequest) error
+	AssignReviewer(assignment models.Assignment) error
+	UnassignReviewer(prId, userId string, at time.Time) error
+	GetAssignments(prId string) ([]models.Assignment, error)
+	// GetUnansweredAssignments возвращает текущие назначения ревьюверов на открытые PR, по которым ревьювер
+	// ещё не оставил ни одного ревью после назначения
+	GetUnansweredAssignments() ([]models.Assignment, error)
+	MarkAssignmentOverdue(prId, userId string, at time.Time) error
+	MarkReassignFailed(prId, userId string, at time.Time) error
+	GetPRsByReviewer(userId string) ([]models.PullRequestShort, error)
+	CountOpenReviews(userIds []string) (map[string]int, error)
+	AddReview(review models.Review) error
+	GetReviews(prId string) ([]models.Review, error)
+	AddPREvent(event models.PullRequestEvent) error
+	// GetPREvents возвращает историю PR в порядке добавления событий
+	GetPREvents(prId string) ([]models.PullRequestEvent, error)
+}
+
+type AvailabilityRepository interface {
+	AddAbsence(absence models.Absence) (models.Absence, error)
+	GetAbsence(id int64) (models.Absence, error)
+	DeleteAbsence(id int64) error
+	GetAbsences(userId string) ([]models.Absence, error)
+	// GetAbsencesAt возвращает отсутствия всех пользователей, которые идут в момент at
+	GetAbsencesAt(at time.Time) ([]models.Absence, error)
+	MarkAbsenceProcessed(id int64) error
+	SetWorkingHours(hours models.WorkingHours) error
+	DeleteWorkingHours(userId string) error
+	// GetWorkingHours возвращает расписания указанных пользователей; у кого расписания нет, в результат не попадают
+	GetWorkingHours(userIds []string) (map[string]models.WorkingHours, error)
+}
+
+type CodeOwnerRepository interface {
+	// GetCodeOwnerRules возвращает правила в порядке добавления
+	GetCodeOwnerRules() ([]models.CodeOwnerRule, error)
+	// SaveCodeOwnerRule добавляет правило или заменяет владельцев существующего с тем же шаблоном, не меняя его позицию
+	SaveCodeOwnerRule(rule models.CodeOwnerRule) error
+	DeleteCodeOwnerRule(pattern string) error
+}
+
+type WebhookRepository interface {
+	CreateWebhook(webhook models.WebhookSubscription) (models.WebhookSubscription, error)
+	GetWebhook(id int64) (models.WebhookSubscription, error)
+	GetWebhooks() ([]models.WebhookSubscription, error)
+	DeleteWebhook(id int64) error
+	AddDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error)
+	GetDelivery(id int64) (models.WebhookDelivery, error)
+	// GetDeliveries возвращает журнал доставок, начиная с последних
+	GetDeliveries(filter models.DeliveryFilter) ([]models.WebhookDelivery, error)
+	// GetDueDeliveries возвращает до limit ожидающих доставок, время попытки которых наступило к at
+	GetDueDeliveries(at time.Time, limit int) ([]models.WebhookDelivery, error)
+	UpdateDelivery(delivery models.WebhookDelivery) error
+}
+
+type ForgeRepository interface {
+	// SetForgeIdentity добавляет или перепривязывает имя пользователя forge
+	SetForgeIdentity(identity models.ForgeIdentity) error
+	DeleteForgeIdentity(forge, username string) error
+	GetForgeIdentities() ([]models.ForgeIdentity, error)
+	GetForgeIdentity(forge, username string) (models.ForgeIdentity, error)
+	GetForgeIdentityByAccount(forge string, accountId int64) (models.ForgeIdentity, error)
+}
+
+type AuditRepository interface {
+	AddAuditEntry(entry models.AuditEntry) error
+	// GetAuditEntries возвращает записи журнала аудита, начиная с последних
+	GetAuditEntries(filter models.AuditFilter) ([]models.AuditEntry, error)
+}
+
+type OutboxRepository interface {
+	AddOutboxEvent(event models.OutboxEvent) error
+	// GetUnpublishedOutboxEvents возвращает до limit событий, ещё не переданных sink, в порядке записи
+	GetUnpublishedOutboxEvents(sink string, limit int) ([]models.OutboxEvent, error)
+	MarkOutboxPublished(sink string, ids []int64) error
+}
+
+type StatsRepository interface {
+	GetAssignmentStats() ([]models.AssignmentStat, error)
+	CountOpenPRsByTeam() (map[string]int, error)
+	// GetReviewerLoads возвращает число открытых ревью для каждого активного пользователя, включая нулевые
+	GetReviewerLoads() ([]models.ReviewerLoad, error)
+}
+
+type Repository interface {
+	// WithTx выполняет fn в одной транзакции; при ошибке все изменения fn откатываются
+	WithTx(fn func(repo Repository) error) error
+	TeamRepository
+	UserRepository
+	PullRequestRepository
+	AvailabilityRepository
+	CodeOwnerRepository
+	WebhookRepository
+	ForgeRepository
+	AuditRepository
+	OutboxRepository
+	StatsRepository
+}
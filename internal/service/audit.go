@@ -0,0 +1,249 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"encoding/json"
+	"time"
+)
+
+// SystemActor записывается автором изменений, которые сервис делает сам, например в фоновых задачах
+const SystemActor = "system"
+
+const (
+	defaultAuditLimit = 100
+	maxAuditLimit     = 500
+)
+
+// WithActor возвращает копию сервиса, которая записывает изменения в журнал аудита от имени actor
+func (s *Service) WithActor(actor string) *Service {
+	c := *s
+	c.actor = actor
+	return &c
+}
+
+func (s *Service) GetAuditLog(filter models.AuditFilter) ([]models.AuditEntry, error) {
+	if !filter.From.IsZero() && !filter.To.IsZero() && !filter.From.Before(filter.To) {
+		return nil, errs.New(errs.ErrValidation, "from must be before to")
+	}
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultAuditLimit
+	case filter.Limit < 0 || filter.Limit > maxAuditLimit:
+		return nil, errs.Newf(errs.ErrValidation, "limit must be between 1 and %d", maxAuditLimit)
+	}
+	return s.repo.GetAuditEntries(filter)
+}
+
+// audit добавляет запись в журнал через repo. Внутри транзакции запись сохраняется только вместе с изменением.
+// before и after сериализуются в JSON; nil означает, что цели не было до или не стало после изменения.
+func (s *Service) audit(repo repository.Repository, entry models.AuditEntry, before, after any) error {
+	var err error
+	if before != nil {
+		if entry.Before, err = json.Marshal(before); err != nil {
+			return err
+		}
+	}
+	if after != nil {
+		if entry.After, err = json.Marshal(after); err != nil {
+			return err
+		}
+	}
+	entry.OccurredAt = s.now()
+	entry.Actor = s.actor
+	if entry.Reason == "" {
+		entry.Reason = models.AuditReasonManual
+	}
+	return repo.AddAuditEntry(entry)
+}
+
+// prAudit заготавливает запись о PR; команда записи - команда автора
+func prAudit(repo repository.Repository, pr models.PullRequest, action, reason string, userIds ...string) (models.AuditEntry, error) {
+	author, err := repo.GetUser(pr.AuthorId)
+	if err != nil {
+		return models.AuditEntry{}, err
+	}
+	return models.AuditEntry{
+		Action:        action,
+		TargetType:    models.AuditTargetPullRequest,
+		TargetId:      pr.PullRequestId,
+		PullRequestId: pr.PullRequestId,
+		UserIds:       append([]string{pr.AuthorId}, userIds...),
+		TeamName:      author.TeamName,
+		Reason:        reason,
+	}, nil
+}
+
+func userAudit(user models.User, action, reason string) models.AuditEntry {
+	return models.AuditEntry{
+		Action:     action,
+		TargetType: models.AuditTargetUser,
+		TargetId:   user.UserId,
+		UserIds:    []string{user.UserId},
+		TeamName:   user.TeamName,
+		Reason:     reason,
+	}
+}
+
+// auditReassignFailure сохраняет неудачную попытку снять ревьювера, которую вызывающий пропускает.
+// Если попытка шла в своей транзакции, та откатана, и запись делается через s.repo; иначе - в транзакции операции.
+func (s *Service) auditReassignFailure(repo repository.Repository, prId, userId, reason string, cause error) error {
+	pr, err := repo.GetPR(prId)
+	if err != nil {
+		return err
+	}
+	entry, err := prAudit(repo, pr, models.AuditPRReassignFailed, reason, userId)
+	if err != nil {
+		return err
+	}
+	entry.Error = cause.Error()
+	return s.audit(repo, entry, pullRequestEvent(pr), nil)
+}
+
+type teamSnapshot struct {
+	TeamName string           `json:"team_name"`
+	Members  []memberSnapshot `json:"members"`
+}
+
+type memberSnapshot struct {
+	UserId   string `json:"user_id"`
+	Username string `json:"username"`
+	IsActive bool   `json:"is_active"`
+}
+
+type teamSettingsSnapshot struct {
+	TeamName             string   `json:"team_name"`
+	ReviewerStrategy     string   `json:"reviewer_strategy"`
+	MinApprovals         int      `json:"min_approvals"`
+	RequireLeadApproval  bool     `json:"require_lead_approval"`
+	MinReviewers         int      `json:"min_reviewers"`
+	MaxReviewers         int      `json:"max_reviewers"`
+	MaxOpenReviews       int      `json:"max_open_reviews"`
+	ReviewSLAMinutes     int      `json:"review_sla_minutes"`
+	ReassignAfterMinutes int      `json:"reassign_after_minutes"`
+	FallbackTeams        []string `json:"fallback_teams"`
+}
+
+func teamSettingsState(settings models.TeamSettings) teamSettingsSnapshot {
+	return teamSettingsSnapshot{
+		TeamName:             settings.TeamName,
+		ReviewerStrategy:     settings.ReviewerStrategy,
+		MinApprovals:         settings.MinApprovals,
+		RequireLeadApproval:  settings.RequireLeadApproval,
+		MinReviewers:         settings.MinReviewers,
+		MaxReviewers:         settings.MaxReviewers,
+		MaxOpenReviews:       settings.MaxOpenReviews,
+		ReviewSLAMinutes:     int(settings.ReviewSLA / time.Minute),
+		ReassignAfterMinutes: int(settings.ReassignAfter / time.Minute),
+		FallbackTeams:        append([]string{}, settings.FallbackTeams...),
+	}
+}
+
+type userSnapshot struct {
+	UserId         string `json:"user_id"`
+	Username       string `json:"username"`
+	TeamName       string `json:"team_name"`
+	IsActive       bool   `json:"is_active"`
+	ReviewWeight   int    `json:"review_weight"`
+	IsLead         bool   `json:"is_lead"`
+	MaxOpenReviews int    `json:"max_open_reviews"`
+}
+
+func userState(user models.User) userSnapshot {
+	return userSnapshot{
+		UserId:         user.UserId,
+		Username:       user.Username,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		ReviewWeight:   user.ReviewWeight,
+		IsLead:         user.IsLead,
+		MaxOpenReviews: user.MaxOpenReviews,
+	}
+}
+
+type reviewSnapshot struct {
+	ReviewerId  string    `json:"reviewer_id"`
+	State       string    `json:"state"`
+	Body        string    `json:"body"`
+	SubmittedAt time.Time `json:"submitted_at"`
+}
+
+type assignmentSnapshot struct {
+	UserId     string    `json:"user_id"`
+	AssignedAt time.Time `json:"assigned_at"`
+	OverdueAt  time.Time `json:"overdue_at"`
+}
+
+type absenceSnapshot struct {
+	Id       int64     `json:"id"`
+	UserId   string    `json:"user_id"`
+	Kind     string    `json:"kind"`
+	StartsAt time.Time `json:"starts_at"`
+	EndsAt   time.Time `json:"ends_at"`
+}
+
+func absenceState(absence models.Absence) absenceSnapshot {
+	return absenceSnapshot{
+		Id:       absence.Id,
+		UserId:   absence.UserId,
+		Kind:     absence.Kind,
+		StartsAt: absence.StartsAt,
+		EndsAt:   absence.EndsAt,
+	}
+}
+
+type workingHoursSnapshot struct {
+	Timezone string `json:"timezone"`
+	Weekdays []int  `json:"weekdays"`
+	Start    string `json:"start"`
+	End      string `json:"end"`
+}
+
+func workingHoursState(hours models.WorkingHours) workingHoursSnapshot {
+	return workingHoursSnapshot{
+		Timezone: hours.Timezone,
+		Weekdays: append([]int{}, hours.Weekdays...),
+		Start:    hours.Start,
+		End:      hours.End,
+	}
+}
+
+type codeOwnerRuleSnapshot struct {
+	Pattern string   `json:"pattern"`
+	Users   []string `json:"users"`
+	Teams   []string `json:"teams"`
+}
+
+func codeOwnerRuleState(rule models.CodeOwnerRule) codeOwnerRuleSnapshot {
+	return codeOwnerRuleSnapshot{
+		Pattern: rule.Pattern,
+		Users:   append([]string{}, rule.Users...),
+		Teams:   append([]string{}, rule.Teams...),
+	}
+}
+
+// webhookSnapshot не содержит секрет подписки
+type webhookSnapshot struct {
+	Id     int64    `json:"id"`
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+func webhookState(webhook models.WebhookSubscription) webhookSnapshot {
+	return webhookSnapshot{Id: webhook.Id, URL: webhook.URL, Events: append([]string{}, webhook.Events...)}
+}
+
+type deliverySnapshot struct {
+	Id       int64  `json:"id"`
+	EventId  string `json:"event_id"`
+	Status   string `json:"status"`
+	Attempts int    `json:"attempts"`
+}
+
+type forgeIdentitySnapshot struct {
+	Forge     string `json:"forge"`
+	Username  string `json:"username"`
+	UserId    string `json:"user_id"`
+	AccountId int64  `json:"account_id,omitempty"`
+}
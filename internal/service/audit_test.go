@@ -0,0 +1,155 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_AuditLog(t *testing.T) {
+	now := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+		},
+		"team2": {
+			{UserId: "author2", Username: "author2", IsActive: true},
+			{UserId: "solo", Username: "solo", IsActive: true},
+		},
+	})
+	svc := NewService(repo, WithClock(func() time.Time { return now }))
+	alice := svc.WithActor("alice")
+
+	addOpenPR(t, repo, "pr1", "author", "rev1", "rev2")
+	_, newReviewer, err := alice.ReassignPR("pr1", "rev1")
+	require.NoError(t, err)
+	assert.Equal(t, "rev3", newReviewer)
+
+	// Замены для единственного ревьювера team2 нет: неудача попадает в журнал, деактивация продолжается
+	addOpenPR(t, repo, "pr2", "author2", "solo")
+	now = now.Add(time.Hour)
+	_, _, err = svc.WithActor("bob").SetUserActive("solo", false, false, false)
+	require.NoError(t, err)
+
+	entries, err := svc.GetAuditLog(models.AuditFilter{UserId: "rev1"})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	e := entries[0]
+	assert.Equal(t, "alice", e.Actor)
+	assert.Equal(t, models.AuditPRReviewerReassigned, e.Action)
+	assert.Equal(t, models.AuditReasonManual, e.Reason)
+	assert.Equal(t, "pr1", e.PullRequestId)
+	assert.Equal(t, "team1", e.TeamName)
+	assert.Equal(t, []string{"author", "rev1", "rev3"}, e.UserIds)
+	var before, after struct {
+		AssignedReviewers []string `json:"assigned_reviewers"`
+	}
+	require.NoError(t, json.Unmarshal(e.Before, &before))
+	require.NoError(t, json.Unmarshal(e.After, &after))
+	assert.Equal(t, []string{"rev1", "rev2"}, before.AssignedReviewers)
+	assert.Equal(t, []string{"rev2", "rev3"}, after.AssignedReviewers)
+
+	entries, err = svc.GetAuditLog(models.AuditFilter{TeamName: "team2"})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, models.AuditUserDeactivated, entries[0].Action)
+	assert.Equal(t, "bob", entries[0].Actor)
+	assert.Equal(t, models.AuditPRReassignFailed, entries[1].Action)
+	assert.Equal(t, models.AuditReasonDeactivation, entries[1].Reason)
+	assert.Contains(t, entries[1].Error, "no active replacement candidate")
+	assert.Nil(t, entries[1].After)
+
+	entries, err = svc.GetAuditLog(models.AuditFilter{From: now.Add(-time.Hour), To: now})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "pr1", entries[0].PullRequestId)
+
+	// Отклонённое изменение не оставляет записи
+	_, err = svc.MergePR("pr1", true)
+	require.NoError(t, err)
+	_, _, err = svc.ReassignPR("pr1", "rev2")
+	assert.ErrorIs(t, err, errs.ErrMerged)
+	entries, err = svc.GetAuditLog(models.AuditFilter{PullRequestId: "pr1"})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, models.AuditPRMerged, entries[0].Action)
+	assert.Equal(t, SystemActor, entries[0].Actor)
+
+	_, err = svc.GetAuditLog(models.AuditFilter{From: now, To: now})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.GetAuditLog(models.AuditFilter{Limit: 501})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+}
+
+func TestService_AuditSettings(t *testing.T) {
+	svc, _ := newTestService(t, map[string][]models.TeamMember{
+		"team1": {{UserId: "u1", Username: "u1", IsActive: true}},
+	})
+
+	_, err := svc.SetUserMaxOpenReviews("u1", 3)
+	require.NoError(t, err)
+	_, err = svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.MinApprovals = 1 })
+	require.NoError(t, err)
+	_, err = svc.SetUserMaxOpenReviews("u1", -1)
+	assert.ErrorIs(t, err, errs.ErrValidation)
+
+	entries, err := svc.GetAuditLog(models.AuditFilter{TeamName: "team1"})
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, models.AuditTeamSettingsUpdated, entries[0].Action)
+	assert.JSONEq(t, `{"team_name":"team1","reviewer_strategy":"","min_approvals":0,"require_lead_approval":false,"min_reviewers":0,
+		"max_reviewers":2,"max_open_reviews":0,"review_sla_minutes":0,"reassign_after_minutes":0,"fallback_teams":[]}`, string(entries[0].Before))
+	assert.Equal(t, models.AuditUserSettingsUpdated, entries[1].Action)
+	assert.Contains(t, string(entries[1].Before), `"max_open_reviews":0`)
+	assert.Contains(t, string(entries[1].After), `"max_open_reviews":3`)
+}
+
+func TestService_SetUserSettings(t *testing.T) {
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {{UserId: "u1", Username: "u1", IsActive: true}},
+	})
+	analytics := &fakeSink{name: "analytics"}
+	svc := NewService(repo, WithOutboxSinks(analytics))
+
+	// Некорректное поле отклоняет весь запрос, остальные поля не сохраняются
+	_, err := svc.SetUserSettings("u1", func(user *models.User) {
+		user.ReviewWeight = 3
+		user.IsLead = true
+		user.MaxOpenReviews = -1
+	})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	before, err := repo.GetUser("u1")
+	require.NoError(t, err)
+	assert.False(t, before.IsLead)
+	_, err = svc.SetUserSettings("unknown", func(user *models.User) {})
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+
+	user, err := svc.SetUserSettings("u1", func(user *models.User) {
+		user.ReviewWeight = 3
+		user.IsLead = true
+		user.MaxOpenReviews = 2
+	})
+	require.NoError(t, err)
+	assert.Equal(t, models.User{UserId: "u1", Username: "u1", TeamName: "team1", IsActive: true, ReviewWeight: 3, IsLead: true, MaxOpenReviews: 2}, user)
+	stored, err := repo.GetUser("u1")
+	require.NoError(t, err)
+	assert.Equal(t, user, stored)
+
+	entries, err := svc.GetAuditLog(models.AuditFilter{UserId: "u1"})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, models.AuditUserSettingsUpdated, entries[0].Action)
+	published, err := svc.ProcessOutbox(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, published)
+	assert.Equal(t, []string{models.EventUserSettingsUpdated}, analytics.events())
+}
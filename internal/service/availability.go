@@ -0,0 +1,281 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"time"
+	// Часовые пояса рабочих часов не должны зависеть от tzdata в образе
+	_ "time/tzdata"
+)
+
+const clockLayout = "15:04"
+
+func (s *Service) AddAbsence(absence models.Absence) (models.Absence, error) {
+	switch absence.Kind {
+	case models.AbsenceVacation, models.AbsenceSickLeave, models.AbsenceOther:
+	default:
+		return models.Absence{}, errs.Newf(errs.ErrValidation, "unknown absence kind %q", absence.Kind)
+	}
+	if !absence.EndsAt.After(absence.StartsAt) {
+		return models.Absence{}, errs.New(errs.ErrValidation, "absence must end after it starts")
+	}
+	err := s.repo.WithTx(func(repo repository.Repository) error {
+		var err error
+		if absence, err = repo.AddAbsence(absence); err != nil {
+			return err
+		}
+		user, err := repo.GetUser(absence.UserId)
+		if err != nil {
+			return err
+		}
+		return s.audit(repo, userAudit(user, models.AuditAbsenceAdded, models.AuditReasonManual), nil, absenceState(absence))
+	})
+	if err != nil {
+		return models.Absence{}, err
+	}
+	return absence, nil
+}
+
+func (s *Service) DeleteAbsence(id int64) error {
+	return s.repo.WithTx(func(repo repository.Repository) error {
+		absence, err := repo.GetAbsence(id)
+		if err != nil {
+			return err
+		}
+		if err := repo.DeleteAbsence(id); err != nil {
+			return err
+		}
+		user, err := repo.GetUser(absence.UserId)
+		if err != nil {
+			return err
+		}
+		return s.audit(repo, userAudit(user, models.AuditAbsenceDeleted, models.AuditReasonManual), absenceState(absence), nil)
+	})
+}
+
+func (s *Service) GetAbsences(userId string) ([]models.Absence, error) {
+	if _, err := s.repo.GetUser(userId); err != nil {
+		return nil, err
+	}
+	return s.repo.GetAbsences(userId)
+}
+
+func (s *Service) GetWorkingHours(userId string) (models.WorkingHours, bool, error) {
+	if _, err := s.repo.GetUser(userId); err != nil {
+		return models.WorkingHours{}, false, err
+	}
+	schedules, err := s.repo.GetWorkingHours([]string{userId})
+	if err != nil {
+		return models.WorkingHours{}, false, err
+	}
+	hours, ok := schedules[userId]
+	return hours, ok, nil
+}
+
+func (s *Service) SetWorkingHours(hours models.WorkingHours) (models.WorkingHours, error) {
+	if _, err := time.LoadLocation(hours.Timezone); err != nil || hours.Timezone == "" {
+		return models.WorkingHours{}, errs.Newf(errs.ErrValidation, "unknown timezone %q", hours.Timezone)
+	}
+	if len(hours.Weekdays) == 0 {
+		return models.WorkingHours{}, errs.New(errs.ErrValidation, "at least one working weekday is required")
+	}
+	seen := make(map[int]bool, len(hours.Weekdays))
+	for _, d := range hours.Weekdays {
+		if d < int(time.Sunday) || d > int(time.Saturday) || seen[d] {
+			return models.WorkingHours{}, errs.Newf(errs.ErrValidation, "invalid or repeated weekday %d", d)
+		}
+		seen[d] = true
+	}
+	start, err := time.Parse(clockLayout, hours.Start)
+	if err != nil {
+		return models.WorkingHours{}, errs.Newf(errs.ErrValidation, "invalid start time %q, expected HH:MM", hours.Start)
+	}
+	end, err := time.Parse(clockLayout, hours.End)
+	if err != nil {
+		return models.WorkingHours{}, errs.Newf(errs.ErrValidation, "invalid end time %q, expected HH:MM", hours.End)
+	}
+	if start.Equal(end) {
+		return models.WorkingHours{}, errs.New(errs.ErrValidation, "working hours must not be empty")
+	}
+	err = s.changeWorkingHours(hours.UserId, models.AuditWorkingHoursSet, func(repo repository.Repository) error {
+		return repo.SetWorkingHours(hours)
+	})
+	if err != nil {
+		return models.WorkingHours{}, err
+	}
+	return hours, nil
+}
+
+func (s *Service) DeleteWorkingHours(userId string) error {
+	return s.changeWorkingHours(userId, models.AuditWorkingHoursDeleted, func(repo repository.Repository) error {
+		return repo.DeleteWorkingHours(userId)
+	})
+}
+
+// changeWorkingHours применяет change к расписанию пользователя и записывает расписание до и после в журнал аудита
+func (s *Service) changeWorkingHours(userId, action string, change func(repo repository.Repository) error) error {
+	return s.repo.WithTx(func(repo repository.Repository) error {
+		user, err := repo.GetUser(userId)
+		if err != nil {
+			return err
+		}
+		state := func() (any, error) {
+			schedules, err := repo.GetWorkingHours([]string{userId})
+			if err != nil {
+				return nil, err
+			}
+			if hours, ok := schedules[userId]; ok {
+				return workingHoursState(hours), nil
+			}
+			return nil, nil
+		}
+		before, err := state()
+		if err != nil {
+			return err
+		}
+		if err := change(repo); err != nil {
+			return err
+		}
+		after, err := state()
+		if err != nil {
+			return err
+		}
+		return s.audit(repo, userAudit(user, action, models.AuditReasonManual), before, after)
+	})
+}
+
+// ProcessAbsences переназначает открытые ревью пользователей, чьё отсутствие уже началось.
+// Каждое отсутствие обрабатывается один раз; ревью, для которых нет замены, остаются у пользователя.
+func (s *Service) ProcessAbsences() (reassigned int, err error) {
+	absences, err := s.repo.GetAbsencesAt(s.now())
+	if err != nil {
+		return 0, err
+	}
+	for _, absence := range absences {
+		if absence.Processed {
+			continue
+		}
+		prs, err := s.repo.GetPRsByReviewer(absence.UserId)
+		if err != nil {
+			return reassigned, err
+		}
+		for _, prShort := range prs {
+			if prShort.Status != "OPEN" {
+				continue
+			}
+			if _, _, err := s.reassign(prShort.PullRequestId, absence.UserId, models.AuditReasonAbsence); err != nil {
+				if err := s.auditReassignFailure(s.repo, prShort.PullRequestId, absence.UserId, models.AuditReasonAbsence, err); err != nil {
+					return reassigned, err
+				}
+				continue
+			}
+			reassigned++
+		}
+		if err := s.repo.MarkAbsenceProcessed(absence.Id); err != nil {
+			return reassigned, err
+		}
+	}
+	return reassigned, nil
+}
+
+// filterAvailable убирает кандидатов, которые сейчас в отсутствии, вне своих рабочих часов или достигли ограничения открытых ревью
+func (s *Service) filterAvailable(repo repository.Repository, users []models.User) ([]models.User, error) {
+	if len(users) == 0 {
+		return nil, nil
+	}
+	now := s.now()
+	absences, err := repo.GetAbsencesAt(now)
+	if err != nil {
+		return nil, err
+	}
+	absent := make(map[string]bool, len(absences))
+	for _, a := range absences {
+		absent[a.UserId] = true
+	}
+	ids := make([]string, len(users))
+	for i, u := range users {
+		ids[i] = u.UserId
+	}
+	schedules, err := repo.GetWorkingHours(ids)
+	if err != nil {
+		return nil, err
+	}
+	var available []models.User
+	for _, u := range users {
+		if absent[u.UserId] {
+			continue
+		}
+		if hours, ok := schedules[u.UserId]; ok && !withinWorkingHours(hours, now) {
+			continue
+		}
+		available = append(available, u)
+	}
+	return s.filterCapacity(repo, available)
+}
+
+// filterCapacity убирает кандидатов, у которых открытых ревью не меньше действующего ограничения
+func (s *Service) filterCapacity(repo repository.Repository, users []models.User) ([]models.User, error) {
+	if len(users) == 0 {
+		return nil, nil
+	}
+	ids := make([]string, len(users))
+	for i, u := range users {
+		ids[i] = u.UserId
+	}
+	load, err := repo.CountOpenReviews(ids)
+	if err != nil {
+		return nil, err
+	}
+	teamLimits := make(map[string]int)
+	var available []models.User
+	for _, u := range users {
+		limit := u.MaxOpenReviews
+		if limit == 0 {
+			teamLimit, ok := teamLimits[u.TeamName]
+			if !ok {
+				settings, err := repo.GetTeamSettings(u.TeamName)
+				if err != nil {
+					return nil, err
+				}
+				teamLimit = settings.MaxOpenReviews
+				teamLimits[u.TeamName] = teamLimit
+			}
+			limit = teamLimit
+		}
+		if limit > 0 && load[u.UserId] >= limit {
+			continue
+		}
+		available = append(available, u)
+	}
+	return available, nil
+}
+
+// withinWorkingHours поддерживает ночные смены: если End раньше Start, смена заканчивается на следующий день
+func withinWorkingHours(hours models.WorkingHours, at time.Time) bool {
+	loc, err := time.LoadLocation(hours.Timezone)
+	if err != nil {
+		return true
+	}
+	start, errStart := time.Parse(clockLayout, hours.Start)
+	end, errEnd := time.Parse(clockLayout, hours.End)
+	if errStart != nil || errEnd != nil {
+		return true
+	}
+	local := at.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	startMinute, endMinute := start.Hour()*60+start.Minute(), end.Hour()*60+end.Minute()
+	worksOn := func(d time.Weekday) bool {
+		for _, w := range hours.Weekdays {
+			if w == int(d) {
+				return true
+			}
+		}
+		return false
+	}
+	if startMinute < endMinute {
+		return worksOn(local.Weekday()) && minute >= startMinute && minute < endMinute
+	}
+	return (worksOn(local.Weekday()) && minute >= startMinute) ||
+		(worksOn((local.Weekday()+6)%7) && minute < endMinute)
+}
@@ -0,0 +1,158 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithinWorkingHours(t *testing.T) {
+	weekdays := []int{1, 2, 3, 4, 5}
+	day := models.WorkingHours{Timezone: "Europe/Moscow", Weekdays: weekdays, Start: "10:00", End: "19:00"}
+	night := models.WorkingHours{Timezone: "Europe/Moscow", Weekdays: weekdays, Start: "22:00", End: "06:00"}
+	tests := []struct {
+		name  string
+		hours models.WorkingHours
+		at    string
+		want  bool
+	}{
+		{"monday noon in Moscow", day, "2025-10-20T09:00:00Z", true},
+		{"monday before start in Moscow", day, "2025-10-20T06:30:00Z", false},
+		{"end is exclusive", day, "2025-10-20T16:00:00Z", false},
+		{"saturday", day, "2025-10-25T09:00:00Z", false},
+		{"night shift started on friday", night, "2025-10-25T01:00:00Z", true},
+		{"night shift does not start on saturday", night, "2025-10-25T20:00:00Z", false},
+		{"monday morning after sunday off", night, "2025-10-27T01:00:00Z", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			at, err := time.Parse(time.RFC3339, tt.at)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, withinWorkingHours(tt.hours, at))
+		})
+	}
+}
+
+func TestService_CreatePR_SkipsUnavailable(t *testing.T) {
+	now := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC) // понедельник, 12:00 по Москве
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+		},
+	})
+	svc := NewService(repo, WithClock(func() time.Time { return now }))
+
+	_, err := svc.AddAbsence(models.Absence{UserId: "rev1", Kind: "HOLIDAY", StartsAt: now, EndsAt: now.Add(time.Hour)})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.AddAbsence(models.Absence{UserId: "rev1", Kind: models.AbsenceVacation, StartsAt: now, EndsAt: now})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.AddAbsence(models.Absence{UserId: "unknown", Kind: models.AbsenceVacation, StartsAt: now, EndsAt: now.Add(time.Hour)})
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	_, err = svc.AddAbsence(models.Absence{UserId: "rev1", Kind: models.AbsenceVacation, StartsAt: now.Add(-24 * time.Hour), EndsAt: now.Add(24 * time.Hour)})
+	require.NoError(t, err)
+
+	_, err = svc.SetWorkingHours(models.WorkingHours{UserId: "rev2", Timezone: "Mars/Olympus", Weekdays: []int{1}, Start: "10:00", End: "19:00"})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SetWorkingHours(models.WorkingHours{UserId: "rev2", Timezone: "Asia/Tokyo", Weekdays: []int{1, 7}, Start: "10:00", End: "19:00"})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SetWorkingHours(models.WorkingHours{UserId: "rev2", Timezone: "Asia/Tokyo", Weekdays: []int{1}, Start: "10am", End: "19:00"})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	// В Токио уже 18:00 понедельника, рабочий день rev2 закончился
+	_, err = svc.SetWorkingHours(models.WorkingHours{UserId: "rev2", Timezone: "Asia/Tokyo", Weekdays: []int{1, 2, 3, 4, 5}, Start: "09:00", End: "18:00"})
+	require.NoError(t, err)
+
+	pr, err := svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev3"}, pr.AssignedReviewers)
+
+	require.NoError(t, svc.DeleteWorkingHours("rev2"))
+	_, ok, err := svc.GetWorkingHours("rev2")
+	require.NoError(t, err)
+	assert.False(t, ok)
+	pr, err = svc.CreatePR("pr2", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"rev2", "rev3"}, pr.AssignedReviewers)
+}
+
+func TestService_ProcessAbsences(t *testing.T) {
+	now := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+		},
+	})
+	svc := NewService(repo, WithClock(func() time.Time { return now }))
+	addOpenPR(t, repo, "pr1", "author1", "rev1")
+
+	absence, err := svc.AddAbsence(models.Absence{UserId: "rev1", Kind: models.AbsenceSickLeave, StartsAt: now.Add(time.Hour), EndsAt: now.Add(48 * time.Hour)})
+	require.NoError(t, err)
+	reassigned, err := svc.ProcessAbsences()
+	require.NoError(t, err)
+	assert.Equal(t, 0, reassigned, "absence has not started yet")
+
+	now = now.Add(2 * time.Hour)
+	reassigned, err = svc.ProcessAbsences()
+	require.NoError(t, err)
+	assert.Equal(t, 1, reassigned)
+	pr, err := repo.GetPR("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev2"}, pr.AssignedReviewers)
+
+	absences, err := svc.GetAbsences("rev1")
+	require.NoError(t, err)
+	require.Len(t, absences, 1)
+	assert.Equal(t, absence.Id, absences[0].Id)
+	assert.True(t, absences[0].Processed)
+
+	// Повторный запуск не трогает уже обработанное отсутствие
+	reassigned, err = svc.ProcessAbsences()
+	require.NoError(t, err)
+	assert.Equal(t, 0, reassigned)
+}
+
+func TestService_ReviewCapacity(t *testing.T) {
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+		},
+	})
+	svc := NewService(repo)
+
+	_, err := svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.MaxOpenReviews = -1 })
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.MaxOpenReviews = 1 })
+	require.NoError(t, err)
+	_, err = svc.SetUserMaxOpenReviews("rev1", -1)
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	user, err := svc.SetUserMaxOpenReviews("rev1", 3)
+	require.NoError(t, err)
+	assert.Equal(t, 3, user.MaxOpenReviews)
+
+	addOpenPR(t, repo, "pr0", "author1", "rev2")
+
+	// У rev2 уже одно ревью при ограничении команды 1
+	pr, err := svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"rev1", "rev3"}, pr.AssignedReviewers)
+
+	// rev3 тоже достиг ограничения, заменить rev1 некем
+	_, _, err = svc.ReassignPR("pr1", "rev1")
+	assert.ErrorIs(t, err, errs.ErrNoCandidate)
+
+	loads, err := svc.GetReviewerLoads()
+	require.NoError(t, err)
+	assert.Contains(t, loads, models.ReviewerLoad{UserId: "rev1", TeamName: "team1", OpenReviews: 1, MaxOpenReviews: 3})
+	assert.Contains(t, loads, models.ReviewerLoad{UserId: "rev2", TeamName: "team1", OpenReviews: 1, MaxOpenReviews: 1})
+}
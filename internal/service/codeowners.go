@@ -0,0 +1,231 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"errors"
+	"path"
+	"strings"
+)
+
+func (s *Service) GetCodeOwnerRules() ([]models.CodeOwnerRule, error) {
+	return s.repo.GetCodeOwnerRules()
+}
+
+func (s *Service) SaveCodeOwnerRule(rule models.CodeOwnerRule) (models.CodeOwnerRule, error) {
+	rule.Pattern = strings.TrimSpace(rule.Pattern)
+	if rule.Pattern == "" {
+		return models.CodeOwnerRule{}, errs.New(errs.ErrValidation, "pattern is required")
+	}
+	if _, err := path.Match(strings.ReplaceAll(rule.Pattern, "**", "*"), ""); err != nil {
+		return models.CodeOwnerRule{}, errs.Newf(errs.ErrValidation, "invalid pattern %q", rule.Pattern)
+	}
+	if len(rule.Users) == 0 && len(rule.Teams) == 0 {
+		return models.CodeOwnerRule{}, errs.New(errs.ErrValidation, "rule must have at least one owner")
+	}
+	for _, userId := range rule.Users {
+		if _, err := s.repo.GetUser(userId); err != nil {
+			return models.CodeOwnerRule{}, ownerError(err, "user", userId)
+		}
+	}
+	for _, team := range rule.Teams {
+		if _, err := s.repo.GetTeamSettings(team); err != nil {
+			return models.CodeOwnerRule{}, ownerError(err, "team", team)
+		}
+	}
+	err := s.repo.WithTx(func(repo repository.Repository) error {
+		before, err := findCodeOwnerRule(repo, rule.Pattern)
+		if err != nil {
+			return err
+		}
+		if err := repo.SaveCodeOwnerRule(rule); err != nil {
+			return err
+		}
+		return s.audit(repo, codeOwnerRuleAudit(rule, models.AuditCodeOwnerRuleSaved), before, codeOwnerRuleState(rule))
+	})
+	if err != nil {
+		return models.CodeOwnerRule{}, err
+	}
+	return rule, nil
+}
+
+func ownerError(err error, kind, id string) error {
+	if errors.Is(err, errs.ErrNotFound) {
+		return errs.Newf(errs.ErrValidation, "owner %s %q not found", kind, id)
+	}
+	return err
+}
+
+func (s *Service) DeleteCodeOwnerRule(pattern string) error {
+	return s.repo.WithTx(func(repo repository.Repository) error {
+		before, err := findCodeOwnerRule(repo, pattern)
+		if err != nil {
+			return err
+		}
+		if err := repo.DeleteCodeOwnerRule(pattern); err != nil {
+			return err
+		}
+		return s.audit(repo, codeOwnerRuleAudit(models.CodeOwnerRule{Pattern: pattern}, models.AuditCodeOwnerRuleDeleted), before, nil)
+	})
+}
+
+// findCodeOwnerRule возвращает снимок правила с шаблоном pattern для журнала аудита или nil, если правила нет
+func findCodeOwnerRule(repo repository.Repository, pattern string) (any, error) {
+	rules, err := repo.GetCodeOwnerRules()
+	if err != nil {
+		return nil, err
+	}
+	for _, r := range rules {
+		if r.Pattern == pattern {
+			return codeOwnerRuleState(r), nil
+		}
+	}
+	return nil, nil
+}
+
+func codeOwnerRuleAudit(rule models.CodeOwnerRule, action string) models.AuditEntry {
+	return models.AuditEntry{
+		Action:     action,
+		TargetType: models.AuditTargetCodeOwnerRule,
+		TargetId:   rule.Pattern,
+		UserIds:    rule.Users,
+	}
+}
+
+// codeOwnerReviewers выбирает до n владельцев изменённых файлов: сначала пользователей из правил,
+// затем по одному участнику от каждой команды-владельца, которая ещё не представлена среди выбранных
+func (s *Service) codeOwnerReviewers(repo repository.Repository, files []string, n int, exclude []string) ([]models.User, error) {
+	if len(files) == 0 || n <= 0 {
+		return nil, nil
+	}
+	rules, err := repo.GetCodeOwnerRules()
+	if err != nil {
+		return nil, err
+	}
+	ownerUsers, ownerTeams := matchCodeOwners(rules, files)
+
+	exclude = append([]string(nil), exclude...)
+	var selected []models.User
+	for _, userId := range ownerUsers {
+		if len(selected) >= n {
+			break
+		}
+		if contains(exclude, userId) {
+			continue
+		}
+		u, err := repo.GetUser(userId)
+		if err != nil {
+			return nil, err
+		}
+		available, err := s.filterAvailable(repo, []models.User{u})
+		if err != nil {
+			return nil, err
+		}
+		if !u.IsActive || len(available) == 0 {
+			continue
+		}
+		selected = append(selected, u)
+		exclude = append(exclude, u.UserId)
+	}
+	for _, team := range ownerTeams {
+		if len(selected) >= n {
+			break
+		}
+		represented := false
+		for _, u := range selected {
+			represented = represented || u.TeamName == team
+		}
+		if represented {
+			continue
+		}
+		users, err := repo.GetUsersByTeam(team)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []models.User
+		for _, u := range users {
+			if u.IsActive && !contains(exclude, u.UserId) {
+				candidates = append(candidates, u)
+			}
+		}
+		candidates, err = s.filterAvailable(repo, candidates)
+		if err != nil {
+			return nil, err
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+		picked, err := s.selectReviewers(repo, team, candidates, 1)
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range picked {
+			selected = append(selected, u)
+			exclude = append(exclude, u.UserId)
+		}
+	}
+	return selected, nil
+}
+
+// matchCodeOwners возвращает владельцев файлов без повторов; для каждого файла берётся последнее подходящее правило
+func matchCodeOwners(rules []models.CodeOwnerRule, files []string) (users, teams []string) {
+	for _, file := range files {
+		for i := len(rules) - 1; i >= 0; i-- {
+			if !matchPattern(rules[i].Pattern, file) {
+				continue
+			}
+			for _, u := range rules[i].Users {
+				if !contains(users, u) {
+					users = append(users, u)
+				}
+			}
+			for _, t := range rules[i].Teams {
+				if !contains(teams, t) {
+					teams = append(teams, t)
+				}
+			}
+			break
+		}
+	}
+	return users, teams
+}
+
+// matchPattern сопоставляет путь с шаблоном CODEOWNERS: шаблон без "/" ищется на любой глубине,
+// "/" в начале привязывает его к корню, "**" означает любое число каталогов, а совпадение с каталогом
+// распространяется на всё его содержимое
+func matchPattern(pattern, file string) bool {
+	file = strings.Trim(file, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	if strings.HasPrefix(pattern, "/") {
+		pattern = strings.TrimPrefix(pattern, "/")
+	} else if !strings.Contains(pattern, "/") {
+		pattern = "**/" + pattern
+	}
+	patternParts, fileParts := strings.Split(pattern, "/"), strings.Split(file, "/")
+	for n := len(fileParts); n > 0; n-- {
+		if matchParts(patternParts, fileParts[:n]) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchParts(pattern, parts []string) bool {
+	if len(pattern) == 0 {
+		return len(parts) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(parts); i++ {
+			if matchParts(pattern[1:], parts[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(parts) == 0 {
+		return false
+	}
+	ok, err := path.Match(pattern[0], parts[0])
+	return ok && err == nil && matchParts(pattern[1:], parts[1:])
+}
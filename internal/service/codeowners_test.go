@@ -0,0 +1,85 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		file    string
+		want    bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "internal/service/service.go", true},
+		{"*.go", "README.md", false},
+		{"/internal/service/", "internal/service/service.go", true},
+		{"/internal/service/", "cmd/internal/service/main.go", false},
+		{"internal/service", "internal/service/strategy/random.go", true},
+		{"docs/**/*.png", "docs/screenshots/a.png", true},
+		{"docs/**/*.png", "docs/a.png", true},
+		{"docs/**/*.png", "src/docs/a.png", false},
+		{"**/migrations", "db/migrations/001.sql", true},
+		{"migrations", "db/migrations/001.sql", true},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, matchPattern(tt.pattern, tt.file), "%s ~ %s", tt.pattern, tt.file)
+	}
+}
+
+func TestMatchCodeOwners_LastRuleWins(t *testing.T) {
+	rules := []models.CodeOwnerRule{
+		{Pattern: "*", Teams: []string{"backend"}},
+		{Pattern: "/payments/", Users: []string{"pay1"}, Teams: []string{"payments"}},
+		{Pattern: "*.md", Users: []string{"docs1"}},
+	}
+	users, teams := matchCodeOwners(rules, []string{"payments/api.go", "payments/README.md", "main.go"})
+	assert.Equal(t, []string{"pay1", "docs1"}, users)
+	assert.Equal(t, []string{"payments", "backend"}, teams)
+}
+
+func TestService_CreatePR_CodeOwners(t *testing.T) {
+	svc, _ := newTestService(t, map[string][]models.TeamMember{
+		"backend": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "back1", Username: "back1", IsActive: true},
+			{UserId: "back2", Username: "back2", IsActive: true},
+		},
+		"payments": {
+			{UserId: "pay1", Username: "pay1", IsActive: true},
+			{UserId: "pay2", Username: "pay2", IsActive: true},
+		},
+		"dba": {{UserId: "dba1", Username: "dba1", IsActive: true}},
+	})
+	_, err := svc.SaveCodeOwnerRule(models.CodeOwnerRule{Pattern: "/payments/"})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SaveCodeOwnerRule(models.CodeOwnerRule{Pattern: "/payments/", Teams: []string{"unknown"}})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SaveCodeOwnerRule(models.CodeOwnerRule{Pattern: "/payments/", Teams: []string{"payments"}})
+	require.NoError(t, err)
+	_, err = svc.SaveCodeOwnerRule(models.CodeOwnerRule{Pattern: "*.sql", Users: []string{"dba1"}})
+	require.NoError(t, err)
+
+	pr, err := svc.CreatePR("pr1", "PR", "author1", nil, []string{"payments/api.go", "README.md"})
+	require.NoError(t, err)
+	require.Len(t, pr.AssignedReviewers, 2)
+	assert.Equal(t, "payments", pr.ReviewerTeams[pr.AssignedReviewers[0]])
+	assert.Equal(t, "backend", pr.ReviewerTeams[pr.AssignedReviewers[1]])
+
+	// Для файла действует последнее подходящее правило, пользователи-владельцы назначаются раньше команд
+	pr, err = svc.CreatePR("pr2", "PR", "author1", nil, []string{"payments/migrations/001.sql", "payments/api.go", "db/002.sql"})
+	require.NoError(t, err)
+	assert.Equal(t, "dba1", pr.AssignedReviewers[0])
+	assert.Equal(t, "payments", pr.ReviewerTeams[pr.AssignedReviewers[1]])
+
+	require.NoError(t, svc.DeleteCodeOwnerRule("*.sql"))
+	assert.ErrorIs(t, svc.DeleteCodeOwnerRule("*.sql"), errs.ErrNotFound)
+	rules, err := svc.GetCodeOwnerRules()
+	require.NoError(t, err)
+	assert.Equal(t, []models.CodeOwnerRule{{Pattern: "/payments/", Teams: []string{"payments"}}}, rules)
+}
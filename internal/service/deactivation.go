@@ -0,0 +1,182 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"errors"
+	"time"
+)
+
+// errDryRun откатывает транзакцию пробного запуска и не возвращается вызывающему
+var errDryRun = errors.New("dry run")
+
+// withDryRun выполняет fn в одной транзакции; при dryRun транзакция откатывается после fn,
+// поэтому план строится теми же шагами, что и настоящий вызов
+func (s *Service) withDryRun(dryRun bool, fn func(repo repository.Repository) error) error {
+	err := s.repo.WithTx(func(repo repository.Repository) error {
+		if err := fn(repo); err != nil {
+			return err
+		}
+		if dryRun {
+			return errDryRun
+		}
+		return nil
+	})
+	if errors.Is(err, errDryRun) {
+		return nil
+	}
+	return err
+}
+
+// DeactivateTeam деактивирует всех пользователей команды и заменяет их в открытых PR в одной транзакции.
+// Пользователи деактивируются до замен, поэтому новые ревьюверы берутся только из резервных команд.
+// Ревьювер, которому не нашлось замены, остаётся назначенным; ошибка любой другой замены откатывает всё.
+func (s *Service) DeactivateTeam(teamName string, dryRun bool) (models.DeactivationReport, error) {
+	start := time.Now()
+	var report models.DeactivationReport
+	err := s.withDryRun(dryRun, func(repo repository.Repository) error {
+		report = models.DeactivationReport{TeamName: teamName, DryRun: dryRun, DeactivatedUsers: []string{}}
+		users, err := repo.GetUsersByTeam(teamName)
+		if err != nil {
+			return err
+		}
+		if err := repo.DeactivateTeam(teamName); err != nil {
+			return err
+		}
+		for _, user := range users {
+			if !user.IsActive {
+				continue
+			}
+			report.DeactivatedUsers = append(report.DeactivatedUsers, user.UserId)
+			updated := user
+			updated.IsActive = false
+			entry := userAudit(user, models.AuditUserDeactivated, models.AuditReasonDeactivation)
+			if err := s.audit(repo, entry, userState(user), userState(updated)); err != nil {
+				return err
+			}
+			if err := s.emit(repo, models.EventUserDeactivated, userEventData{UserId: user.UserId, TeamName: teamName}); err != nil {
+				return err
+			}
+		}
+		for _, userId := range report.DeactivatedUsers {
+			reassignments, err := s.reassignReviews(repo, userId, models.AuditReasonDeactivation)
+			if err != nil {
+				return err
+			}
+			report.Reassignments = append(report.Reassignments, reassignments...)
+		}
+		if report.UnderReviewed, err = underReviewed(repo, report.Reassignments); err != nil {
+			return err
+		}
+		team := teamEventData{TeamName: teamName, UserIds: report.DeactivatedUsers, Reassigned: replaced(report.Reassignments)}
+		err = s.audit(repo, models.AuditEntry{
+			Action:     models.AuditTeamDeactivated,
+			TargetType: models.AuditTargetTeam,
+			TargetId:   teamName,
+			UserIds:    report.DeactivatedUsers,
+			TeamName:   teamName,
+		}, nil, team)
+		if err != nil {
+			return err
+		}
+		return s.emit(repo, models.EventTeamDeactivated, team)
+	})
+	if err != nil {
+		return models.DeactivationReport{}, err
+	}
+	if !dryRun {
+		s.reportReassignments(report.Reassignments)
+		s.metrics.TeamDeactivated(time.Since(start), replaced(report.Reassignments))
+	}
+	return report, nil
+}
+
+// reassignReviews заменяет userId во всех его открытых PR в транзакции repo. Если замены нет, ревьювер
+// остаётся назначенным, а причина попадает в результат и журнал аудита; другие ошибки прерывают замены.
+func (s *Service) reassignReviews(repo repository.Repository, userId, reason string) ([]models.Reassignment, error) {
+	prs, err := repo.GetPRsByReviewer(userId)
+	if err != nil {
+		return nil, err
+	}
+	var reassignments []models.Reassignment
+	for _, prShort := range prs {
+		if prShort.Status != "OPEN" {
+			continue
+		}
+		r := models.Reassignment{PullRequestId: prShort.PullRequestId, OldReviewerId: userId}
+		_, newReviewer, err := s.reassignPR(repo, prShort.PullRequestId, userId, reason)
+		switch {
+		case err == nil:
+			r.NewReviewerId = newReviewer
+		case errors.Is(err, errs.ErrNoCandidate):
+			r.Reason = err.Error()
+			if err := s.auditReassignFailure(repo, prShort.PullRequestId, userId, reason, err); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, err
+		}
+		reassignments = append(reassignments, r)
+	}
+	return reassignments, nil
+}
+
+// reportReassignments передаёт в метрики исходы сохранённых замен
+func (s *Service) reportReassignments(reassignments []models.Reassignment) {
+	for _, r := range reassignments {
+		if r.NewReviewerId != "" {
+			s.metrics.Reassigned(reassignOutcome(nil))
+		} else {
+			s.metrics.Reassigned(reassignOutcome(errs.ErrNoCandidate))
+		}
+	}
+}
+
+func replaced(reassignments []models.Reassignment) int {
+	n := 0
+	for _, r := range reassignments {
+		if r.NewReviewerId != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// underReviewed проверяет затронутые PR после замен
+func underReviewed(repo repository.Repository, reassignments []models.Reassignment) ([]models.UnderReviewedPR, error) {
+	result := []models.UnderReviewedPR{}
+	var checked []string
+	for _, r := range reassignments {
+		if contains(checked, r.PullRequestId) {
+			continue
+		}
+		checked = append(checked, r.PullRequestId)
+		pr, err := repo.GetPR(r.PullRequestId)
+		if err != nil {
+			return nil, err
+		}
+		author, err := repo.GetUser(pr.AuthorId)
+		if err != nil {
+			return nil, err
+		}
+		settings, err := repo.GetTeamSettings(author.TeamName)
+		if err != nil {
+			return nil, err
+		}
+		active := []string{}
+		for _, id := range pr.AssignedReviewers {
+			reviewer, err := repo.GetUser(id)
+			if err != nil {
+				return nil, err
+			}
+			if reviewer.IsActive {
+				active = append(active, id)
+			}
+		}
+		if len(active) == 0 || len(active) < settings.MinReviewers {
+			result = append(result, models.UnderReviewedPR{PullRequestId: pr.PullRequestId, ActiveReviewers: active, MinReviewers: settings.MinReviewers})
+		}
+	}
+	return result, nil
+}
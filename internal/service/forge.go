@@ -0,0 +1,148 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/forge"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"errors"
+	"strings"
+)
+
+func (s *Service) SetForgeIdentity(identity models.ForgeIdentity) (models.ForgeIdentity, error) {
+	if identity.Forge != forge.GitHub && identity.Forge != forge.GitLab {
+		return models.ForgeIdentity{}, errs.Newf(errs.ErrValidation, "unknown forge %q", identity.Forge)
+	}
+	identity.Username = strings.TrimSpace(identity.Username)
+	if identity.Username == "" {
+		return models.ForgeIdentity{}, errs.New(errs.ErrValidation, "username is required")
+	}
+	if identity.AccountId < 0 {
+		return models.ForgeIdentity{}, errs.New(errs.ErrValidation, "account id must be positive")
+	}
+	err := s.repo.WithTx(func(repo repository.Repository) error {
+		if identity.AccountId != 0 {
+			other, err := repo.GetForgeIdentityByAccount(identity.Forge, identity.AccountId)
+			switch {
+			case err == nil && other.Username != identity.Username:
+				return errs.Newf(errs.ErrValidation, "%s account %d is already mapped to %q", identity.Forge, identity.AccountId, other.Username)
+			case err != nil && !errors.Is(err, errs.ErrNotFound):
+				return err
+			}
+		}
+		var before any
+		previous, err := repo.GetForgeIdentity(identity.Forge, identity.Username)
+		switch {
+		case err == nil:
+			before = forgeIdentitySnapshot(previous)
+		case !errors.Is(err, errs.ErrNotFound):
+			return err
+		}
+		if err := repo.SetForgeIdentity(identity); err != nil {
+			return err
+		}
+		return s.audit(repo, forgeIdentityAudit(identity, models.AuditForgeIdentitySet), before, forgeIdentitySnapshot(identity))
+	})
+	if err != nil {
+		return models.ForgeIdentity{}, err
+	}
+	return identity, nil
+}
+
+func (s *Service) DeleteForgeIdentity(forgeName, username string) error {
+	return s.repo.WithTx(func(repo repository.Repository) error {
+		identity, err := repo.GetForgeIdentity(forgeName, username)
+		if err != nil {
+			return err
+		}
+		if err := repo.DeleteForgeIdentity(forgeName, username); err != nil {
+			return err
+		}
+		return s.audit(repo, forgeIdentityAudit(identity, models.AuditForgeIdentityDeleted), forgeIdentitySnapshot(identity), nil)
+	})
+}
+
+func forgeIdentityAudit(identity models.ForgeIdentity, action string) models.AuditEntry {
+	return models.AuditEntry{
+		Action:     action,
+		TargetType: models.AuditTargetForgeIdentity,
+		TargetId:   forgeKey(identity.Forge, identity.Username),
+		UserIds:    []string{identity.UserId},
+	}
+}
+
+func forgeKey(forgeName, username string) string {
+	return forgeName + "/" + username
+}
+
+func (s *Service) GetForgeIdentities() ([]models.ForgeIdentity, error) {
+	return s.repo.GetForgeIdentities()
+}
+
+// HandleForgeEvent создаёт PR по событию открытия и сливает по событию слияния. Повторная доставка события
+// не меняет состояние и возвращает ignored. Слияние на стороне forge уже произошло, поэтому если политика
+// команды не выполнена, PR сливается с force и помечается force_merged.
+func (s *Service) HandleForgeEvent(ev forge.Event) (models.ForgeEventResult, error) {
+	result := models.ForgeEventResult{PullRequestId: ev.PullRequestId}
+	ignore := func(reason string) (models.ForgeEventResult, error) {
+		result.Outcome, result.Reason = models.ForgeOutcomeIgnored, reason
+		return result, nil
+	}
+	switch ev.Action {
+	case forge.ActionOpened:
+		identity, err := s.forgeAuthor(ev)
+		if err != nil {
+			return models.ForgeEventResult{}, err
+		}
+		_, err = s.openPR(ev.PullRequestId, ev.Title, identity.UserId, nil, nil, models.AuditReasonForge)
+		if errors.Is(err, errs.ErrPRExists) {
+			return ignore("pull request already exists")
+		}
+		if err != nil {
+			return models.ForgeEventResult{}, err
+		}
+		result.Outcome = models.ForgeOutcomeCreated
+		return result, nil
+	case forge.ActionMerged:
+		pr, err := s.repo.GetPR(ev.PullRequestId)
+		if errors.Is(err, errs.ErrNotFound) {
+			return ignore("pull request was not opened through this service")
+		}
+		if err != nil {
+			return models.ForgeEventResult{}, err
+		}
+		if pr.Status == "MERGED" {
+			return ignore("pull request is already merged")
+		}
+		_, err = s.mergePR(ev.PullRequestId, false, models.AuditReasonForge)
+		if errors.Is(err, errs.ErrMergeBlocked) {
+			_, err = s.mergePR(ev.PullRequestId, true, models.AuditReasonForge)
+		}
+		if err != nil {
+			return models.ForgeEventResult{}, err
+		}
+		result.Outcome = models.ForgeOutcomeMerged
+		return result, nil
+	default:
+		return ignore("closing without merge is not tracked")
+	}
+}
+
+// forgeAuthor ищет пользователя сервиса по логину автора, а если его нет в событии - по id аккаунта автора
+func (s *Service) forgeAuthor(ev forge.Event) (models.ForgeIdentity, error) {
+	if ev.AuthorLogin != "" {
+		identity, err := s.repo.GetForgeIdentity(ev.Forge, ev.AuthorLogin)
+		if errors.Is(err, errs.ErrNotFound) {
+			return models.ForgeIdentity{}, errs.Newf(errs.ErrValidation, "%s user %q is not mapped to a user_id", ev.Forge, ev.AuthorLogin)
+		}
+		return identity, err
+	}
+	if ev.AuthorAccountId == 0 {
+		return models.ForgeIdentity{}, errs.Newf(errs.ErrValidation, "%s event has no author", ev.Forge)
+	}
+	identity, err := s.repo.GetForgeIdentityByAccount(ev.Forge, ev.AuthorAccountId)
+	if errors.Is(err, errs.ErrNotFound) {
+		return models.ForgeIdentity{}, errs.Newf(errs.ErrValidation, "%s account %d is not mapped to a user_id", ev.Forge, ev.AuthorAccountId)
+	}
+	return identity, err
+}
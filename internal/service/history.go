@@ -0,0 +1,61 @@
+package service
+
+import (
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+)
+
+func (s *Service) GetPRHistory(prId string) (models.PullRequestHistory, error) {
+	if _, err := s.repo.GetPR(prId); err != nil {
+		return models.PullRequestHistory{}, err
+	}
+	events, err := s.repo.GetPREvents(prId)
+	if err != nil {
+		return models.PullRequestHistory{}, err
+	}
+	return models.PullRequestHistory{Events: events, State: replayPR(events)}, nil
+}
+
+// recordPR добавляет события в историю PR через repo, поэтому они сохраняются только вместе с изменением
+func (s *Service) recordPR(repo repository.Repository, events ...models.PullRequestEvent) error {
+	for _, e := range events {
+		e.Actor = s.actor
+		if err := repo.AddPREvent(e); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replayPR восстанавливает состояние PR по его истории: автора, статус, время создания и слияния и текущих
+// ревьюверов в порядке назначения
+func replayPR(events []models.PullRequestEvent) models.PullRequest {
+	var pr models.PullRequest
+	for _, e := range events {
+		at := e.OccurredAt
+		switch e.Type {
+		case models.PREventCreated:
+			pr = models.PullRequest{
+				PullRequestId:   e.PullRequestId,
+				PullRequestName: e.PullRequestName,
+				AuthorId:        e.UserId,
+				Status:          "OPEN",
+				CreatedAt:       &at,
+			}
+		case models.PREventReviewerAssigned:
+			pr.AssignedReviewers = append(pr.AssignedReviewers, e.UserId)
+		case models.PREventReviewerUnassigned:
+			for i, r := range pr.AssignedReviewers {
+				if r == e.UserId {
+					pr.AssignedReviewers = append(pr.AssignedReviewers[:i:i], pr.AssignedReviewers[i+1:]...)
+					break
+				}
+			}
+		case models.PREventMerged:
+			pr.Status = "MERGED"
+			pr.MergedAt = &at
+			pr.ForceMerged = e.ForceMerged
+		}
+	}
+	return pr
+}
@@ -0,0 +1,86 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_PRHistory(t *testing.T) {
+	now := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+			{UserId: "rev4", Username: "rev4", IsActive: true},
+		},
+	})
+	svc := NewService(repo, WithClock(func() time.Time { return now }))
+
+	pr, err := svc.WithActor("alice").CreatePR("pr1", "Add feature", "author", nil, nil)
+	require.NoError(t, err)
+	first, second := pr.AssignedReviewers[0], pr.AssignedReviewers[1]
+
+	now = now.Add(time.Minute)
+	_, err = svc.SubmitReview("pr1", first, models.ReviewApproved, "")
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	_, _, err = svc.ReassignPR("pr1", first)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	_, _, err = svc.SetUserActive(second, false, false, false)
+	require.NoError(t, err)
+	now = now.Add(time.Minute)
+	_, err = svc.MergePR("pr1", true)
+	require.NoError(t, err)
+
+	history, err := svc.GetPRHistory("pr1")
+	require.NoError(t, err)
+	var types, reasons []string
+	for _, e := range history.Events {
+		types = append(types, e.Type)
+		reasons = append(reasons, e.Reason)
+	}
+	assert.Equal(t, []string{
+		models.PREventCreated, models.PREventReviewerAssigned, models.PREventReviewerAssigned,
+		models.PREventReviewSubmitted,
+		models.PREventReviewerUnassigned, models.PREventReviewerAssigned,
+		models.PREventReviewerUnassigned, models.PREventReviewerAssigned,
+		models.PREventMerged,
+	}, types)
+	assert.Equal(t, []string{
+		"", models.AuditReasonManual, models.AuditReasonManual,
+		"",
+		models.AuditReasonManual, models.AuditReasonManual,
+		models.AuditReasonDeactivation, models.AuditReasonDeactivation,
+		"",
+	}, reasons)
+	assert.Equal(t, "alice", history.Events[0].Actor)
+	assert.Equal(t, "Add feature", history.Events[0].PullRequestName)
+	assert.Equal(t, first, history.Events[4].UserId)
+	assert.Equal(t, second, history.Events[6].UserId)
+	assert.True(t, history.Events[8].ForceMerged)
+
+	// Состояние, восстановленное по истории, совпадает с сохранённым
+	stored, err := repo.GetPR("pr1")
+	require.NoError(t, err)
+	state := history.State
+	assert.Equal(t, stored.PullRequestId, state.PullRequestId)
+	assert.Equal(t, stored.PullRequestName, state.PullRequestName)
+	assert.Equal(t, stored.AuthorId, state.AuthorId)
+	assert.Equal(t, stored.Status, state.Status)
+	assert.Equal(t, stored.AssignedReviewers, state.AssignedReviewers)
+	assert.Equal(t, stored.CreatedAt, state.CreatedAt)
+	assert.Equal(t, stored.MergedAt, state.MergedAt)
+	assert.Equal(t, stored.ForceMerged, state.ForceMerged)
+	assert.NotContains(t, state.AssignedReviewers, second)
+
+	_, err = svc.GetPRHistory("missing")
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
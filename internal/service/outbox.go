@@ -0,0 +1,59 @@
+package service
+
+import (
+	"avito-internship/internal/outbox"
+	"context"
+	"errors"
+	"fmt"
+)
+
+// outboxBatch - сколько событий передаётся sink за один пакет
+const outboxBatch = 100
+
+// WithOutboxSinks задаёт получателей событий из outbox; без них ProcessOutbox ничего не делает
+func WithOutboxSinks(sinks ...outbox.Sink) Option {
+	return func(s *Service) {
+		s.outboxSinks = sinks
+	}
+}
+
+// ProcessOutbox передаёт каждому sink события, которые он ещё не получил, пакетами в порядке записи.
+// Ошибка одного sink не задерживает остальные; непереданный пакет повторяется при следующем запуске.
+func (s *Service) ProcessOutbox(ctx context.Context) (published int, err error) {
+	var errList []error
+	for _, sink := range s.outboxSinks {
+		n, err := s.relay(ctx, sink)
+		published += n
+		if err != nil {
+			errList = append(errList, fmt.Errorf("outbox sink %s: %w", sink.Name(), err))
+		}
+	}
+	return published, errors.Join(errList...)
+}
+
+func (s *Service) relay(ctx context.Context, sink outbox.Sink) (published int, err error) {
+	for ctx.Err() == nil {
+		events, err := s.repo.GetUnpublishedOutboxEvents(sink.Name(), outboxBatch)
+		if err != nil || len(events) == 0 {
+			return published, err
+		}
+		messages := make([]outbox.Message, len(events))
+		ids := make([]int64, len(events))
+		for i, e := range events {
+			messages[i] = outbox.Message{Id: e.EventId, Event: e.Event, Payload: e.Payload}
+			ids[i] = e.Id
+		}
+		if err := sink.Publish(ctx, messages); err != nil {
+			return published, err
+		}
+		// Если отметка не сохранится, пакет уйдёт повторно: получатель отбрасывает повторы по id
+		if err := s.repo.MarkOutboxPublished(sink.Name(), ids); err != nil {
+			return published, err
+		}
+		published += len(events)
+		if len(events) < outboxBatch {
+			return published, nil
+		}
+	}
+	return published, ctx.Err()
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"avito-internship/internal/models"
+	"avito-internship/internal/outbox"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSink struct {
+	name     string
+	messages []outbox.Message
+	err      error
+}
+
+func (f *fakeSink) Name() string { return f.name }
+
+func (f *fakeSink) Publish(_ context.Context, messages []outbox.Message) error {
+	f.messages = append(f.messages, messages...)
+	return f.err
+}
+
+func (f *fakeSink) events() []string {
+	var events []string
+	for _, m := range f.messages {
+		events = append(events, m.Event)
+	}
+	return events
+}
+
+func TestService_Outbox(t *testing.T) {
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+		},
+	})
+	analytics := &fakeSink{name: "analytics"}
+	broken := &fakeSink{name: "broken", err: errors.New("unavailable")}
+	svc := NewService(repo, WithOutboxSinks(analytics, broken))
+
+	// События пишутся без подписок на вебхуки
+	_, err := svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	_, _, err = svc.SetUserActive("rev1", false, false, false)
+	require.NoError(t, err)
+
+	published, err := svc.ProcessOutbox(context.Background())
+	assert.ErrorContains(t, err, "broken")
+	assert.Equal(t, 2, published)
+	assert.Equal(t, []string{models.EventPRCreated, models.EventUserDeactivated}, analytics.events())
+	var created struct {
+		Id   string
+		Data struct {
+			PullRequestId string `json:"pull_request_id"`
+		}
+	}
+	require.NoError(t, json.Unmarshal(analytics.messages[0].Payload, &created))
+	assert.Equal(t, analytics.messages[0].Id, created.Id)
+	assert.Equal(t, "pr1", created.Data.PullRequestId)
+
+	// Отклонённое изменение не попадает в outbox
+	_, err = svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.Error(t, err)
+	_, err = svc.MergePR("pr1", true)
+	require.NoError(t, err)
+
+	// Каждый sink продолжает со своего места; недоступный получает пакет заново
+	broken.err = nil
+	published, err = svc.ProcessOutbox(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1+3, published)
+	assert.Equal(t, []string{models.EventPRCreated, models.EventUserDeactivated, models.EventPRMerged}, analytics.events())
+	assert.Equal(t, []string{
+		models.EventPRCreated, models.EventUserDeactivated,
+		models.EventPRCreated, models.EventUserDeactivated, models.EventPRMerged,
+	}, broken.events())
+	assert.Equal(t, broken.messages[0].Id, broken.messages[2].Id)
+
+	published, err = svc.ProcessOutbox(context.Background())
+	require.NoError(t, err)
+	assert.Zero(t, published)
+}
+
+func TestService_OutboxSharesWebhookEventId(t *testing.T) {
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+		},
+	})
+	sink := &fakeSink{name: "file"}
+	svc := NewService(repo, WithOutboxSinks(sink), WithWebhookSender(&fakeSender{}))
+	bot, err := svc.CreateWebhook("https://bot", []string{models.EventPRCreated}, "s3cret")
+	require.NoError(t, err)
+	_, err = svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+
+	_, err = svc.ProcessOutbox(context.Background())
+	require.NoError(t, err)
+	deliveries, err := svc.GetDeliveries(models.DeliveryFilter{WebhookId: bot.Id})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	require.Len(t, sink.messages, 1)
+	assert.Equal(t, deliveries[0].EventId, sink.messages[0].Id)
+	assert.JSONEq(t, string(deliveries[0].Payload), string(sink.messages[0].Payload))
+}
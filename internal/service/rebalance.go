@@ -0,0 +1,146 @@
+package service
+
+import (
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"slices"
+	"strings"
+)
+
+// RebalanceTeam выравнивает нагрузку активных участников команды в одной транзакции; при dryRun возвращает план
+func (s *Service) RebalanceTeam(teamName string, dryRun bool) (models.RebalanceReport, error) {
+	var report models.RebalanceReport
+	err := s.withDryRun(dryRun, func(repo repository.Repository) error {
+		var err error
+		report, err = s.rebalance(repo, teamName, dryRun)
+		return err
+	})
+	if err != nil {
+		return models.RebalanceReport{}, err
+	}
+	if !dryRun {
+		s.reportReassignments(report.Moves)
+	}
+	return report, nil
+}
+
+// rebalance переносит ревью открытых PR, по которым ревьювер ещё ничего не оставил, от самых загруженных
+// активных участников команды к наименее загруженным доступным, пока разница нагрузки больше одного ревью.
+// Автор PR и уже назначенные ревьюверы не получают ревью, как и при reassign.
+func (s *Service) rebalance(repo repository.Repository, teamName string, dryRun bool) (models.RebalanceReport, error) {
+	if _, err := repo.GetTeamSettings(teamName); err != nil {
+		return models.RebalanceReport{}, err
+	}
+	users, err := repo.GetUsersByTeam(teamName)
+	if err != nil {
+		return models.RebalanceReport{}, err
+	}
+	var members []models.User
+	var ids []string
+	for _, u := range users {
+		if u.IsActive {
+			members = append(members, u)
+			ids = append(ids, u.UserId)
+		}
+	}
+	report := models.RebalanceReport{TeamName: teamName, DryRun: dryRun, Moves: []models.Reassignment{}, Loads: []models.MemberLoad{}}
+	if len(members) == 0 {
+		return report, nil
+	}
+	before, err := repo.CountOpenReviews(ids)
+	if err != nil {
+		return models.RebalanceReport{}, err
+	}
+	for {
+		move, ok, err := s.nextRebalanceMove(repo, members)
+		if err != nil {
+			return models.RebalanceReport{}, err
+		}
+		if !ok {
+			break
+		}
+		pr, err := repo.GetPR(move.PullRequestId)
+		if err != nil {
+			return models.RebalanceReport{}, err
+		}
+		if _, err := s.replaceReviewer(repo, pr, move.OldReviewerId, move.NewReviewerId, models.AuditReasonRebalance); err != nil {
+			return models.RebalanceReport{}, err
+		}
+		report.Moves = append(report.Moves, move)
+	}
+	after, err := repo.CountOpenReviews(ids)
+	if err != nil {
+		return models.RebalanceReport{}, err
+	}
+	for _, id := range ids {
+		report.Loads = append(report.Loads, models.MemberLoad{UserId: id, Before: before[id], After: after[id]})
+	}
+	return report, nil
+}
+
+// nextRebalanceMove ищет перенос, сокращающий разницу нагрузки двух участников хотя бы на два ревью.
+// Каждый такой перенос уменьшает сумму квадратов нагрузок, поэтому переносы когда-нибудь заканчиваются.
+func (s *Service) nextRebalanceMove(repo repository.Repository, members []models.User) (models.Reassignment, bool, error) {
+	ids := make([]string, len(members))
+	for i, u := range members {
+		ids[i] = u.UserId
+	}
+	load, err := repo.CountOpenReviews(ids)
+	if err != nil {
+		return models.Reassignment{}, false, err
+	}
+	receivers, err := s.filterAvailable(repo, members)
+	if err != nil || len(receivers) == 0 {
+		return models.Reassignment{}, false, err
+	}
+	// При равной нагрузке порядок по user_id, чтобы план и реальный запуск совпадали
+	byLoad := func(desc bool) func(a, b models.User) int {
+		return func(a, b models.User) int {
+			if d := load[a.UserId] - load[b.UserId]; d != 0 {
+				if desc {
+					return -d
+				}
+				return d
+			}
+			return strings.Compare(a.UserId, b.UserId)
+		}
+	}
+	slices.SortFunc(receivers, byLoad(false))
+	donors := slices.Clone(members)
+	slices.SortFunc(donors, byLoad(true))
+	minLoad := load[receivers[0].UserId]
+
+	for _, donor := range donors {
+		if load[donor.UserId]-minLoad < 2 {
+			break
+		}
+		prs, err := repo.GetPRsByReviewer(donor.UserId)
+		if err != nil {
+			return models.Reassignment{}, false, err
+		}
+		for _, prShort := range prs {
+			if prShort.Status != "OPEN" {
+				continue
+			}
+			details, err := getPRDetails(repo, prShort.PullRequestId)
+			if err != nil {
+				return models.Reassignment{}, false, err
+			}
+			if !slices.ContainsFunc(details.Reviewers, func(r models.ReviewerState) bool {
+				return r.UserId == donor.UserId && r.State == models.ReviewPending
+			}) {
+				continue
+			}
+			for _, receiver := range receivers {
+				if load[donor.UserId]-load[receiver.UserId] < 2 {
+					break
+				}
+				if receiver.UserId == details.AuthorId || contains(details.AssignedReviewers, receiver.UserId) {
+					continue
+				}
+				return models.Reassignment{PullRequestId: prShort.PullRequestId, OldReviewerId: donor.UserId, NewReviewerId: receiver.UserId}, true, nil
+			}
+		}
+	}
+	return models.Reassignment{}, false, nil
+}
@@ -0,0 +1,115 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_RebalanceTeam(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+		},
+	})
+	addOpenPR(t, repo, "pr1", "author1", "rev1", "rev2")
+	addOpenPR(t, repo, "pr2", "author1", "rev1", "rev2")
+	addOpenPR(t, repo, "pr3", "author1", "rev1")
+	addOpenPR(t, repo, "pr4", "rev3", "rev1")
+	// Ревью, по которому уже есть отзыв, не переносится
+	_, err := svc.SubmitReview("pr1", "rev1", models.ReviewApproved, "")
+	require.NoError(t, err)
+
+	want := models.RebalanceReport{
+		TeamName: "team1",
+		DryRun:   true,
+		Moves: []models.Reassignment{
+			{PullRequestId: "pr2", OldReviewerId: "rev1", NewReviewerId: "rev3"},
+			{PullRequestId: "pr3", OldReviewerId: "rev1", NewReviewerId: "rev3"},
+			// Автор pr4 - rev3, поэтому ревью уходит автору других PR
+			{PullRequestId: "pr4", OldReviewerId: "rev1", NewReviewerId: "author1"},
+		},
+	}
+	wantLoads := []models.MemberLoad{
+		{UserId: "author1", Before: 0, After: 1},
+		{UserId: "rev1", Before: 4, After: 1},
+		{UserId: "rev2", Before: 2, After: 2},
+		{UserId: "rev3", Before: 0, After: 2},
+	}
+	plan, err := svc.RebalanceTeam("team1", true)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, wantLoads, plan.Loads)
+	plan.Loads = nil
+	assert.Equal(t, want, plan)
+	pr, err := repo.GetPR("pr2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev1", "rev2"}, pr.AssignedReviewers)
+
+	report, err := svc.RebalanceTeam("team1", false)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, wantLoads, report.Loads)
+	report.Loads = nil
+	want.DryRun = false
+	assert.Equal(t, want, report)
+	pr, err = repo.GetPR("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev1", "rev2"}, pr.AssignedReviewers)
+	pr, err = repo.GetPR("pr4")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"author1"}, pr.AssignedReviewers)
+	history, err := svc.GetPRHistory("pr2")
+	require.NoError(t, err)
+	last := history.Events[len(history.Events)-1]
+	assert.Equal(t, "rev3", last.UserId)
+	assert.Equal(t, models.AuditReasonRebalance, last.Reason)
+
+	// Нагрузка уже выровнена
+	report, err = svc.RebalanceTeam("team1", false)
+	require.NoError(t, err)
+	assert.Empty(t, report.Moves)
+
+	_, err = svc.RebalanceTeam("unknown", false)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestService_SetUserActive_Rebalance(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: false},
+		},
+	})
+	addOpenPR(t, repo, "pr1", "author1", "rev1", "rev2")
+	addOpenPR(t, repo, "pr2", "author1", "rev1", "rev2")
+
+	_, report, err := svc.SetUserActive("rev3", true, true, false)
+	require.NoError(t, err)
+	assert.Nil(t, report.Rebalance)
+
+	_, report, err = svc.SetUserActive("rev3", true, true, true)
+	require.NoError(t, err)
+	require.NotNil(t, report.Rebalance)
+	assert.True(t, report.Rebalance.DryRun)
+	want := []models.Reassignment{{PullRequestId: "pr1", OldReviewerId: "rev1", NewReviewerId: "rev3"}}
+	assert.Equal(t, want, report.Rebalance.Moves)
+	u, err := repo.GetUser("rev3")
+	require.NoError(t, err)
+	assert.False(t, u.IsActive)
+
+	user, report, err := svc.SetUserActive("rev3", true, false, true)
+	require.NoError(t, err)
+	assert.True(t, user.IsActive)
+	require.NotNil(t, report.Rebalance)
+	assert.Equal(t, want, report.Rebalance.Moves)
+	pr, err := repo.GetPR("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev2", "rev3"}, pr.AssignedReviewers)
+}
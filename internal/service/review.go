@@ -0,0 +1,233 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+func (s *Service) SubmitReview(prId, reviewerId, state, body string) (details models.PullRequestDetails, err error) {
+	switch state {
+	case models.ReviewApproved:
+	case models.ReviewChangesRequested, models.ReviewCommented:
+		if body == "" {
+			return models.PullRequestDetails{}, errs.Newf(errs.ErrValidation, "review body is required for %s", state)
+		}
+	default:
+		return models.PullRequestDetails{}, errs.Newf(errs.ErrValidation, "unknown review state %q", state)
+	}
+	err = s.repo.WithTx(func(repo repository.Repository) error {
+		pr, err := repo.GetPR(prId)
+		if err != nil {
+			return err
+		}
+		if pr.Status == "MERGED" {
+			return errs.New(errs.ErrMerged, "cannot review merged PR")
+		}
+		if !contains(pr.AssignedReviewers, reviewerId) {
+			lead, err := approvingLead(repo, pr, reviewerId)
+			if err != nil {
+				return err
+			}
+			if !lead {
+				return errs.New(errs.ErrNotAssigned, "reviewer is not assigned to this PR")
+			}
+		}
+		// Ревью меняет состояние PR, поэтому конкурирующий reassign этого ревьювера должен получить конфликт
+		if err := repo.UpdatePR(pr); err != nil {
+			return err
+		}
+		review := models.Review{
+			PullRequestId: prId,
+			ReviewerId:    reviewerId,
+			State:         state,
+			Body:          body,
+			SubmittedAt:   s.now(),
+		}
+		if err := repo.AddReview(review); err != nil {
+			return err
+		}
+		err = s.recordPR(repo, models.PullRequestEvent{
+			PullRequestId: prId,
+			Type:          models.PREventReviewSubmitted,
+			OccurredAt:    review.SubmittedAt,
+			UserId:        reviewerId,
+			ReviewState:   state,
+		})
+		if err != nil {
+			return err
+		}
+		entry, err := prAudit(repo, pr, models.AuditPRReviewSubmitted, models.AuditReasonManual, reviewerId)
+		if err != nil {
+			return err
+		}
+		err = s.audit(repo, entry, nil, reviewSnapshot{ReviewerId: reviewerId, State: state, Body: body, SubmittedAt: review.SubmittedAt})
+		if err != nil {
+			return err
+		}
+		details, err = getPRDetails(repo, prId)
+		return err
+	})
+	if err != nil {
+		return models.PullRequestDetails{}, err
+	}
+	return details, nil
+}
+
+func (s *Service) GetPRDetails(prId string) (models.PullRequestDetails, error) {
+	return getPRDetails(s.repo, prId)
+}
+
+func getPRDetails(repo repository.Repository, prId string) (models.PullRequestDetails, error) {
+	pr, err := repo.GetPR(prId)
+	if err != nil {
+		return models.PullRequestDetails{}, err
+	}
+	assignments, err := repo.GetAssignments(prId)
+	if err != nil {
+		return models.PullRequestDetails{}, err
+	}
+	reviews, err := repo.GetReviews(prId)
+	if err != nil {
+		return models.PullRequestDetails{}, err
+	}
+	return models.PullRequestDetails{
+		PullRequest: pr,
+		Reviewers:   reviewerStates(assignments, reviews),
+		Reviews:     reviews,
+	}, nil
+}
+
+// reviewerStates учитывает только ревью, оставленные после текущего назначения: вердикт снятого
+// ревьювера не переносится, даже если его назначат снова. COMMENTED не отменяет APPROVED и CHANGES_REQUESTED.
+func reviewerStates(assignments []models.Assignment, reviews []models.Review) []models.ReviewerState {
+	var states []models.ReviewerState
+	for _, a := range assignments {
+		if a.UnassignedAt != nil {
+			continue
+		}
+		state := models.ReviewerState{UserId: a.UserId, State: models.ReviewPending}
+		for _, r := range reviews {
+			if r.ReviewerId != a.UserId || r.SubmittedAt.Before(a.AssignedAt) {
+				continue
+			}
+			if r.State == models.ReviewCommented && state.State != models.ReviewPending && state.State != models.ReviewCommented {
+				continue
+			}
+			submittedAt := r.SubmittedAt
+			state.State = r.State
+			state.SubmittedAt = &submittedAt
+		}
+		states = append(states, state)
+	}
+	return states
+}
+
+// mergeBlockers возвращает невыполненные условия политики слияния команды автора PR
+func mergeBlockers(repo repository.Repository, pr models.PullRequest) ([]string, error) {
+	author, err := repo.GetUser(pr.AuthorId)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := repo.GetTeamSettings(author.TeamName)
+	if err != nil {
+		return nil, err
+	}
+	details, err := getPRDetails(repo, pr.PullRequestId)
+	if err != nil {
+		return nil, err
+	}
+
+	var blockers []string
+	approvals, leadApproved := 0, false
+	for _, r := range details.Reviewers {
+		switch r.State {
+		case models.ReviewApproved:
+			approvals++
+			if settings.RequireLeadApproval && !leadApproved {
+				leadApproved, err = isTeamLead(repo, r.UserId, author.TeamName)
+				if err != nil {
+					return nil, err
+				}
+			}
+		case models.ReviewChangesRequested:
+			blockers = append(blockers, fmt.Sprintf("changes requested by %s", r.UserId))
+		}
+	}
+	if settings.RequireLeadApproval {
+		// Вердикты лидов, которые оставили ревью, не будучи назначенными; в min_approvals они не входят
+		var unassigned []models.Assignment
+		for _, r := range details.Reviews {
+			if !contains(details.AssignedReviewers, r.ReviewerId) &&
+				!slices.ContainsFunc(unassigned, func(a models.Assignment) bool { return a.UserId == r.ReviewerId }) {
+				unassigned = append(unassigned, models.Assignment{UserId: r.ReviewerId})
+			}
+		}
+		for _, r := range reviewerStates(unassigned, details.Reviews) {
+			lead, err := isTeamLead(repo, r.UserId, author.TeamName)
+			if err != nil {
+				return nil, err
+			}
+			if !lead {
+				continue
+			}
+			switch r.State {
+			case models.ReviewApproved:
+				leadApproved = true
+			case models.ReviewChangesRequested:
+				blockers = append(blockers, fmt.Sprintf("changes requested by %s", r.UserId))
+			}
+		}
+	}
+	if approvals < settings.MinApprovals {
+		blockers = append(blockers, fmt.Sprintf("%d of %d required approvals", approvals, settings.MinApprovals))
+	}
+	if settings.RequireLeadApproval && !leadApproved {
+		blockers = append(blockers, "approval from a team lead is required")
+	}
+	return blockers, nil
+}
+
+// approvingLead сообщает, может ли неназначенный пользователь оставить ревью как лид: политика команды автора
+// требует одобрения лида, а случайный выбор ревьюверов не обязан назначить лида на PR
+func approvingLead(repo repository.Repository, pr models.PullRequest, userId string) (bool, error) {
+	if userId == pr.AuthorId {
+		return false, nil
+	}
+	author, err := repo.GetUser(pr.AuthorId)
+	if err != nil {
+		return false, err
+	}
+	settings, err := repo.GetTeamSettings(author.TeamName)
+	if err != nil {
+		return false, err
+	}
+	if !settings.RequireLeadApproval {
+		return false, nil
+	}
+	lead, err := isTeamLead(repo, userId, author.TeamName)
+	if errors.Is(err, errs.ErrNotFound) {
+		return false, nil
+	}
+	return lead, err
+}
+
+func isTeamLead(repo repository.Repository, userId, teamName string) (bool, error) {
+	user, err := repo.GetUser(userId)
+	if err != nil {
+		return false, err
+	}
+	return user.IsLead && user.TeamName == teamName, nil
+}
+
+func contains(ids []string, id string) bool {
+	for _, v := range ids {
+		if v == id {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_SubmitReview(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+		},
+	})
+	addOpenPR(t, repo, "pr1", "author1", "rev1", "rev2")
+
+	details, err := svc.SubmitReview("pr1", "rev1", models.ReviewApproved, "")
+	require.NoError(t, err)
+	require.Len(t, details.Reviewers, 2)
+	assert.Equal(t, models.ReviewApproved, details.Reviewers[0].State)
+	assert.NotNil(t, details.Reviewers[0].SubmittedAt)
+	assert.Equal(t, models.ReviewPending, details.Reviewers[1].State)
+
+	// Комментарий после одобрения не отменяет его, но попадает в историю
+	_, err = svc.SubmitReview("pr1", "rev1", models.ReviewCommented, "nit: typo")
+	require.NoError(t, err)
+	details, err = svc.SubmitReview("pr1", "rev2", models.ReviewChangesRequested, "needs tests")
+	require.NoError(t, err)
+	assert.Equal(t, models.ReviewApproved, details.Reviewers[0].State)
+	assert.Equal(t, models.ReviewChangesRequested, details.Reviewers[1].State)
+	require.Len(t, details.Reviews, 3)
+	assert.Equal(t, "nit: typo", details.Reviews[1].Body)
+
+	_, err = svc.SubmitReview("pr1", "rev1", "LGTM", "")
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SubmitReview("pr1", "rev1", models.ReviewChangesRequested, "")
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SubmitReview("pr1", "author1", models.ReviewApproved, "")
+	assert.ErrorIs(t, err, errs.ErrNotAssigned)
+	_, err = svc.SubmitReview("unknown", "rev1", models.ReviewApproved, "")
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+
+	// Запрошенные изменения блокируют слияние, пока его не форсируют
+	_, err = svc.MergePR("pr1", false)
+	assert.ErrorIs(t, err, errs.ErrMergeBlocked)
+	_, err = svc.MergePR("pr1", true)
+	require.NoError(t, err)
+	_, err = svc.SubmitReview("pr1", "rev1", models.ReviewApproved, "")
+	assert.ErrorIs(t, err, errs.ErrMerged)
+}
+
+func TestService_ReassignPR_DropsReviewState(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+		},
+	})
+	addOpenPR(t, repo, "pr1", "author1", "rev1")
+
+	_, err := svc.SubmitReview("pr1", "rev1", models.ReviewChangesRequested, "needs tests")
+	require.NoError(t, err)
+	_, newReviewer, err := svc.ReassignPR("pr1", "rev1")
+	require.NoError(t, err)
+	assert.Equal(t, "rev2", newReviewer)
+
+	details, err := svc.GetPRDetails("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []models.ReviewerState{{UserId: "rev2", State: models.ReviewPending}}, details.Reviewers)
+	require.Len(t, details.Reviews, 1)
+
+	// Вердикт, оставленный до повторного назначения, не возвращается
+	_, newReviewer, err = svc.ReassignPR("pr1", "rev2")
+	require.NoError(t, err)
+	assert.Equal(t, "rev1", newReviewer)
+	details, err = svc.GetPRDetails("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []models.ReviewerState{{UserId: "rev1", State: models.ReviewPending}}, details.Reviewers)
+}
+
+func TestService_MergePR_Policy(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "lead1", Username: "lead", IsActive: true},
+		},
+	})
+	_, err := svc.SetUserLead("lead1", true)
+	require.NoError(t, err)
+	_, err = svc.SetTeamSettings("team1", func(settings *models.TeamSettings) {
+		settings.MinApprovals = 2
+		settings.RequireLeadApproval = true
+	})
+	require.NoError(t, err)
+	_, err = svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.MinApprovals = -1 })
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	addOpenPR(t, repo, "pr1", "author1", "rev1", "lead1")
+
+	_, err = svc.SubmitReview("pr1", "rev1", models.ReviewApproved, "")
+	require.NoError(t, err)
+	_, err = svc.SubmitReview("pr1", "lead1", models.ReviewChangesRequested, "needs tests")
+	require.NoError(t, err)
+	_, err = svc.MergePR("pr1", false)
+	assert.ErrorIs(t, err, errs.ErrMergeBlocked)
+	assert.Equal(t, []string{
+		"changes requested by lead1",
+		"1 of 2 required approvals",
+		"approval from a team lead is required",
+	}, errs.Details(err))
+
+	_, err = svc.SubmitReview("pr1", "lead1", models.ReviewApproved, "")
+	require.NoError(t, err)
+	pr, err := svc.MergePR("pr1", false)
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", pr.Status)
+	assert.False(t, pr.ForceMerged)
+}
+
+func TestService_MergePR_UnassignedLead(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "lead1", Username: "lead", IsActive: true},
+		},
+		"team2": {
+			{UserId: "lead2", Username: "lead2", IsActive: true},
+		},
+	})
+	for _, id := range []string{"lead1", "lead2"} {
+		_, err := svc.SetUserLead(id, true)
+		require.NoError(t, err)
+	}
+	// Лида не выбрали ревьювером
+	addOpenPR(t, repo, "pr1", "author1", "rev1")
+
+	// Пока политика не требует лида, неназначенный лид не может оставить ревью
+	_, err := svc.SubmitReview("pr1", "lead1", models.ReviewApproved, "")
+	assert.ErrorIs(t, err, errs.ErrNotAssigned)
+	_, err = svc.SetTeamSettings("team1", func(settings *models.TeamSettings) {
+		settings.MinApprovals = 1
+		settings.RequireLeadApproval = true
+	})
+	require.NoError(t, err)
+
+	_, err = svc.SubmitReview("pr1", "rev1", models.ReviewApproved, "")
+	require.NoError(t, err)
+	_, err = svc.MergePR("pr1", false)
+	assert.ErrorIs(t, err, errs.ErrMergeBlocked)
+	assert.Equal(t, []string{"approval from a team lead is required"}, errs.Details(err))
+
+	_, err = svc.SubmitReview("pr1", "rev2", models.ReviewApproved, "")
+	assert.ErrorIs(t, err, errs.ErrNotAssigned)
+	_, err = svc.SubmitReview("pr1", "lead2", models.ReviewApproved, "")
+	assert.ErrorIs(t, err, errs.ErrNotAssigned)
+
+	_, err = svc.SubmitReview("pr1", "lead1", models.ReviewChangesRequested, "split the migration")
+	require.NoError(t, err)
+	_, err = svc.MergePR("pr1", false)
+	assert.ErrorIs(t, err, errs.ErrMergeBlocked)
+	assert.Equal(t, []string{"changes requested by lead1", "approval from a team lead is required"}, errs.Details(err))
+
+	details, err := svc.SubmitReview("pr1", "lead1", models.ReviewApproved, "")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev1"}, details.AssignedReviewers)
+	pr, err := svc.MergePR("pr1", false)
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", pr.Status)
+	assert.False(t, pr.ForceMerged)
+}
+
+func TestService_MergePR_Force(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+		},
+	})
+	_, err := svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.MinApprovals = 1 })
+	require.NoError(t, err)
+	addOpenPR(t, repo, "pr1", "author1", "rev1")
+
+	_, err = svc.MergePR("pr1", false)
+	assert.ErrorIs(t, err, errs.ErrMergeBlocked)
+	pr, err := svc.MergePR("pr1", true)
+	require.NoError(t, err)
+	assert.True(t, pr.ForceMerged)
+
+	stored, err := repo.GetPR("pr1")
+	require.NoError(t, err)
+	assert.True(t, stored.ForceMerged)
+}
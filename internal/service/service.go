@@ -1,77 +1,223 @@
 package service
 
 import (
+	"avito-internship/internal/errs"
 	"avito-internship/internal/models"
-	"avito-internship/internal/storage"
+	"avito-internship/internal/outbox"
+	"avito-internship/internal/repository"
+	"avito-internship/internal/webhook"
 	"errors"
-	"math/rand"
+	"net/http"
+	"slices"
+	"strings"
 	"time"
 )
 
 type Service struct {
-	storage *storage.Storage
+	repo            repository.Repository
+	defaultStrategy ReviewerStrategy
+	metrics         Metrics
+	webhooks        WebhookSender
+	outboxSinks     []outbox.Sink
+	now             func() time.Time
+	// actor - автор изменений в журнале аудита, задаётся через WithActor
+	actor string
 }
 
-func NewService(storage *storage.Storage) *Service {
-	return &Service{storage: storage}
+// Metrics получает доменные события сервиса для экспорта в мониторинг
+type Metrics interface {
+	PRCreated()
+	PRMerged()
+	Reassigned(outcome string)
+	TeamDeactivated(duration time.Duration, reassigned int)
+}
+
+type noopMetrics struct{}
+
+func (noopMetrics) PRCreated()                         {}
+func (noopMetrics) PRMerged()                          {}
+func (noopMetrics) Reassigned(string)                  {}
+func (noopMetrics) TeamDeactivated(time.Duration, int) {}
+
+type Option func(*Service)
+
+func WithMetrics(metrics Metrics) Option {
+	return func(s *Service) {
+		s.metrics = metrics
+	}
+}
+
+// WithClock подменяет текущее время, по которому проверяется доступность ревьюверов
+func WithClock(now func() time.Time) Option {
+	return func(s *Service) {
+		s.now = now
+	}
+}
+
+func WithDefaultStrategy(strategy ReviewerStrategy) Option {
+	return func(s *Service) {
+		s.defaultStrategy = strategy
+	}
+}
+
+func NewService(repo repository.Repository, opts ...Option) *Service {
+	s := &Service{
+		repo:            repo,
+		defaultStrategy: randomStrategy{},
+		metrics:         noopMetrics{},
+		webhooks:        webhook.NewSender(&http.Client{Timeout: 10 * time.Second}),
+		now:             time.Now,
+		actor:           SystemActor,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 func (s *Service) AddTeam(teamName string, members []models.TeamMember) error {
-	return s.storage.AddTeam(teamName, members)
+	return s.repo.WithTx(func(repo repository.Repository) error {
+		if err := repo.AddTeam(teamName, members); err != nil {
+			return err
+		}
+		team := teamSnapshot{TeamName: teamName, Members: make([]memberSnapshot, len(members))}
+		var userIds []string
+		for i, m := range members {
+			team.Members[i] = memberSnapshot{UserId: m.UserId, Username: m.Username, IsActive: m.IsActive}
+			userIds = append(userIds, m.UserId)
+		}
+		return s.audit(repo, models.AuditEntry{
+			Action:     models.AuditTeamCreated,
+			TargetType: models.AuditTargetTeam,
+			TargetId:   teamName,
+			UserIds:    userIds,
+			TeamName:   teamName,
+		}, nil, team)
+	})
 }
 
 func (s *Service) GetTeam(teamName string) ([]models.TeamMember, error) {
-	return s.storage.GetTeam(teamName)
+	return s.repo.GetTeam(teamName)
 }
 
-func (s *Service) SetUserActive(userId string, isActive bool) error {
-	user, err := s.storage.GetUser(userId)
-	if err != nil {
-		return err
-	}
-	if user.IsActive && !isActive {
-		// Переназначить все открытые PR, где он ревьювер
-		prs, err := s.storage.GetPRsByReviewer(userId)
+func (s *Service) GetUser(userId string) (models.User, error) {
+	return s.repo.GetUser(userId)
+}
+
+// SetUserActive меняет флаг активности. При деактивации пользователь в той же транзакции заменяется
+// во всех открытых PR, как в DeactivateTeam, а при активации с rebalance нагрузка команды выравнивается,
+// как в RebalanceTeam. При dryRun возвращается план без сохранения.
+func (s *Service) SetUserActive(userId string, isActive, dryRun, rebalance bool) (models.User, models.DeactivationReport, error) {
+	var updated models.User
+	var report models.DeactivationReport
+	err := s.withDryRun(dryRun, func(repo repository.Repository) error {
+		user, err := repo.GetUser(userId)
 		if err != nil {
 			return err
 		}
-		for _, prShort := range prs {
-			if prShort.Status == "OPEN" {
-				_, _, err := s.ReassignPR(prShort.PullRequestId, userId)
-				if err != nil {
-					// Если переназначение невозможно, пропустить, но продолжить деактивацию
-					continue
-				}
+		report = models.DeactivationReport{TeamName: user.TeamName, DryRun: dryRun, DeactivatedUsers: []string{}}
+		if user.IsActive && !isActive {
+			report.DeactivatedUsers = append(report.DeactivatedUsers, userId)
+			if report.Reassignments, err = s.reassignReviews(repo, userId, models.AuditReasonDeactivation); err != nil {
+				return err
 			}
 		}
+		if err := repo.SetUserActive(userId, isActive); err != nil {
+			return err
+		}
+		updated = user
+		updated.IsActive = isActive
+		if user.IsActive == isActive {
+			return nil
+		}
+		action := models.AuditUserActivated
+		if !isActive {
+			action = models.AuditUserDeactivated
+		}
+		if err := s.audit(repo, userAudit(user, action, models.AuditReasonManual), userState(user), userState(updated)); err != nil {
+			return err
+		}
+		if isActive {
+			if !rebalance {
+				return nil
+			}
+			moves, err := s.rebalance(repo, user.TeamName, dryRun)
+			report.Rebalance = &moves
+			return err
+		}
+		if err := s.emit(repo, models.EventUserDeactivated, userEventData{UserId: userId, TeamName: user.TeamName}); err != nil {
+			return err
+		}
+		// Активность ревьюверов проверяется после деактивации, чтобы снимаемый пользователь не считался
+		report.UnderReviewed, err = underReviewed(repo, report.Reassignments)
+		return err
+	})
+	if err != nil {
+		return models.User{}, models.DeactivationReport{}, err
+	}
+	if !dryRun {
+		s.reportReassignments(report.Reassignments)
+		if report.Rebalance != nil {
+			s.reportReassignments(report.Rebalance.Moves)
+		}
 	}
-	return s.storage.SetUserActive(userId, isActive)
+	return updated, report, nil
+}
+
+// CreatePR назначает MaxReviewers ревьюверов: сначала владельцев changedFiles, затем участников команды автора.
+// reviewerCount задаёт другое число ревьюверов в пределах настроек команды.
+func (s *Service) CreatePR(prId, prName, authorId string, reviewerCount *int, changedFiles []string) (models.PullRequest, error) {
+	return s.openPR(prId, prName, authorId, reviewerCount, changedFiles, models.AuditReasonManual)
 }
 
-func (s *Service) CreatePR(prId, prName, authorId string) (models.PullRequest, error) {
-	author, err := s.storage.GetUser(authorId)
+func (s *Service) openPR(prId, prName, authorId string, reviewerCount *int, changedFiles []string, reason string) (pr models.PullRequest, err error) {
+	err = s.repo.WithTx(func(repo repository.Repository) error {
+		pr, err = s.createPR(repo, prId, prName, authorId, reviewerCount, changedFiles, reason)
+		return err
+	})
+	if err == nil {
+		s.metrics.PRCreated()
+	}
+	return pr, err
+}
+
+func (s *Service) createPR(repo repository.Repository, prId, prName, authorId string, reviewerCount *int, changedFiles []string, reason string) (models.PullRequest, error) {
+	author, err := repo.GetUser(authorId)
 	if err != nil {
 		return models.PullRequest{}, err
 	}
-	teamUsers, err := s.storage.GetUsersByTeam(author.TeamName)
+	settings, err := repo.GetTeamSettings(author.TeamName)
 	if err != nil {
 		return models.PullRequest{}, err
 	}
-	var activeReviewers []models.User
-	for _, u := range teamUsers {
-		if u.IsActive && u.UserId != authorId {
-			activeReviewers = append(activeReviewers, u)
+	count := settings.MaxReviewers
+	if reviewerCount != nil {
+		if *reviewerCount < settings.MinReviewers || *reviewerCount > settings.MaxReviewers {
+			return models.PullRequest{}, errs.Newf(errs.ErrValidation, "reviewer count must be between %d and %d for team %s",
+				settings.MinReviewers, settings.MaxReviewers, author.TeamName)
 		}
+		count = *reviewerCount
 	}
-	rand.Seed(time.Now().UnixNano())
-	rand.Shuffle(len(activeReviewers), func(i, j int) {
-		activeReviewers[i], activeReviewers[j] = activeReviewers[j], activeReviewers[i]
-	})
+	selected, err := s.codeOwnerReviewers(repo, changedFiles, count, []string{authorId})
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+	exclude := []string{authorId}
+	for _, u := range selected {
+		exclude = append(exclude, u.UserId)
+	}
+	rest, err := s.pickReviewers(repo, author.TeamName, count-len(selected), exclude)
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+	selected = append(selected, rest...)
 	var reviewers []string
-	for i := 0; i < len(activeReviewers) && i < 2; i++ {
-		reviewers = append(reviewers, activeReviewers[i].UserId)
+	reviewerTeams := make(map[string]string, len(selected))
+	for _, u := range selected {
+		reviewers = append(reviewers, u.UserId)
+		reviewerTeams[u.UserId] = u.TeamName
 	}
-	now := time.Now()
+	now := s.now()
 	pr := models.PullRequest{
 		PullRequestId:     prId,
 		PullRequestName:   prName,
@@ -79,114 +225,430 @@ func (s *Service) CreatePR(prId, prName, authorId string) (models.PullRequest, e
 		Status:            "OPEN",
 		AssignedReviewers: reviewers,
 		CreatedAt:         &now,
+		UnderReviewed:     len(reviewers) < settings.MinReviewers,
+	}
+	err = repo.CreatePR(pr)
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+	events := []models.PullRequestEvent{{
+		PullRequestId:   prId,
+		Type:            models.PREventCreated,
+		OccurredAt:      now,
+		UserId:          authorId,
+		PullRequestName: prName,
+	}}
+	for _, id := range reviewers {
+		events = append(events, models.PullRequestEvent{
+			PullRequestId: prId,
+			Type:          models.PREventReviewerAssigned,
+			OccurredAt:    now,
+			UserId:        id,
+			Reason:        reason,
+		})
 	}
-	err = s.storage.CreatePR(pr)
+	if err := s.recordPR(repo, events...); err != nil {
+		return models.PullRequest{}, err
+	}
+	if err := s.emit(repo, models.EventPRCreated, pullRequestEvent(pr)); err != nil {
+		return models.PullRequest{}, err
+	}
+	entry, err := prAudit(repo, pr, models.AuditPRCreated, reason, reviewers...)
 	if err != nil {
 		return models.PullRequest{}, err
 	}
+	if err := s.audit(repo, entry, nil, pullRequestEvent(pr)); err != nil {
+		return models.PullRequest{}, err
+	}
+	pr.ReviewerTeams = reviewerTeams
 	return pr, nil
 }
 
-func (s *Service) MergePR(prId string) (models.PullRequest, error) {
-	pr, err := s.storage.GetPR(prId)
+// MergePR сливает PR, если выполнена политика слияния команды автора; force позволяет слить в обход неё
+func (s *Service) MergePR(prId string, force bool) (models.PullRequest, error) {
+	return s.mergePR(prId, force, models.AuditReasonManual)
+}
+
+func (s *Service) mergePR(prId string, force bool, reason string) (pr models.PullRequest, err error) {
+	merged := false
+	err = s.repo.WithTx(func(repo repository.Repository) error {
+		pr, err = repo.GetPR(prId)
+		if err != nil {
+			return err
+		}
+		if pr.Status == "MERGED" {
+			return nil
+		}
+		if !force {
+			blockers, err := mergeBlockers(repo, pr)
+			if err != nil {
+				return err
+			}
+			if len(blockers) > 0 {
+				return errs.WithDetails(errs.ErrMergeBlocked, "merge policy is not satisfied: "+strings.Join(blockers, "; "), blockers)
+			}
+		}
+		before := pullRequestEvent(pr)
+		pr.Status = "MERGED"
+		pr.ForceMerged = force
+		now := s.now()
+		pr.MergedAt = &now
+		if err := repo.UpdatePR(pr); err != nil {
+			return err
+		}
+		pr.Version++
+		merged = true
+		err := s.recordPR(repo, models.PullRequestEvent{
+			PullRequestId: prId,
+			Type:          models.PREventMerged,
+			OccurredAt:    now,
+			ForceMerged:   force,
+		})
+		if err != nil {
+			return err
+		}
+		entry, err := prAudit(repo, pr, models.AuditPRMerged, reason)
+		if err != nil {
+			return err
+		}
+		if err := s.audit(repo, entry, before, pullRequestEvent(pr)); err != nil {
+			return err
+		}
+		return s.emit(repo, models.EventPRMerged, pullRequestEvent(pr))
+	})
 	if err != nil {
 		return models.PullRequest{}, err
 	}
-	if pr.Status == "MERGED" {
-		return pr, nil
+	if merged {
+		s.metrics.PRMerged()
 	}
-	pr.Status = "MERGED"
-	now := time.Now()
-	pr.MergedAt = &now
-	return pr, s.storage.UpdatePR(pr)
+	return pr, nil
 }
 
 func (s *Service) ReassignPR(prId, oldUserId string) (models.PullRequest, string, error) {
-	pr, err := s.storage.GetPR(prId)
+	return s.reassign(prId, oldUserId, models.AuditReasonManual)
+}
+
+// reassign заменяет ревьювера в отдельной транзакции; reason записывается в журнал аудита
+func (s *Service) reassign(prId, oldUserId, reason string) (pr models.PullRequest, newReviewer string, err error) {
+	err = s.repo.WithTx(func(repo repository.Repository) error {
+		pr, newReviewer, err = s.reassignPR(repo, prId, oldUserId, reason)
+		return err
+	})
+	s.metrics.Reassigned(reassignOutcome(err))
+	return pr, newReviewer, err
+}
+
+// reassignOutcome использует коды ошибок API, чтобы метрики совпадали с ответами клиентам
+func reassignOutcome(err error) string {
+	switch {
+	case err == nil:
+		return "success"
+	case errors.Is(err, errs.ErrNoCandidate):
+		return "NO_CANDIDATE"
+	case errors.Is(err, errs.ErrNotAssigned):
+		return "NOT_ASSIGNED"
+	case errors.Is(err, errs.ErrMerged):
+		return "PR_MERGED"
+	case errors.Is(err, errs.ErrNotFound):
+		return "NOT_FOUND"
+	case errors.Is(err, errs.ErrConflict):
+		return "CONFLICT"
+	default:
+		return "INTERNAL"
+	}
+}
+
+func (s *Service) reassignPR(repo repository.Repository, prId, oldUserId, reason string) (models.PullRequest, string, error) {
+	pr, err := repo.GetPR(prId)
 	if err != nil {
 		return models.PullRequest{}, "", err
 	}
 	if pr.Status == "MERGED" {
-		return models.PullRequest{}, "", errors.New("cannot reassign on merged PR")
+		return models.PullRequest{}, "", errs.New(errs.ErrMerged, "cannot reassign on merged PR")
 	}
-	found := false
-	for i, r := range pr.AssignedReviewers {
-		if r == oldUserId {
-			pr.AssignedReviewers = append(pr.AssignedReviewers[:i], pr.AssignedReviewers[i+1:]...)
-			found = true
-			break
-		}
+	if !contains(pr.AssignedReviewers, oldUserId) {
+		return models.PullRequest{}, "", errs.New(errs.ErrNotAssigned, "reviewer is not assigned to this PR")
 	}
-	if !found {
-		return models.PullRequest{}, "", errors.New("reviewer is not assigned to this PR")
-	}
-	oldUser, err := s.storage.GetUser(oldUserId)
+	oldUser, err := repo.GetUser(oldUserId)
 	if err != nil {
 		return models.PullRequest{}, "", err
 	}
-	teamUsers, err := s.storage.GetUsersByTeam(oldUser.TeamName)
+	exclude := append([]string{pr.AuthorId}, pr.AssignedReviewers...)
+	selected, err := s.pickReviewers(repo, oldUser.TeamName, 1, exclude)
 	if err != nil {
 		return models.PullRequest{}, "", err
 	}
-	var activeReviewers []models.User
-	for _, u := range teamUsers {
-		if u.IsActive && u.UserId != pr.AuthorId {
-			assigned := false
-			for _, ar := range pr.AssignedReviewers {
-				if ar == u.UserId {
-					assigned = true
-					break
-				}
-			}
-			if !assigned {
-				activeReviewers = append(activeReviewers, u)
-			}
-		}
+	if len(selected) == 0 {
+		return models.PullRequest{}, "", errs.New(errs.ErrNoCandidate, "no active replacement candidate in team")
 	}
-	if len(activeReviewers) == 0 {
-		return models.PullRequest{}, "", errors.New("no active replacement candidate in team")
+	newReviewer := selected[0]
+	if pr, err = s.replaceReviewer(repo, pr, oldUserId, newReviewer.UserId, reason); err != nil {
+		return models.PullRequest{}, "", err
 	}
-	rand.Seed(time.Now().UnixNano())
-	newReviewer := activeReviewers[rand.Intn(len(activeReviewers))]
-	pr.AssignedReviewers = append(pr.AssignedReviewers, newReviewer.UserId)
-	err = s.storage.UpdatePR(pr)
-	return pr, newReviewer.UserId, err
+	return pr, newReviewer.UserId, nil
 }
 
-func (s *Service) GetPRsByReviewer(userId string) ([]models.PullRequestShort, error) {
-	_, err := s.storage.GetUser(userId)
+// replaceReviewer снимает oldUserId с открытого PR и назначает newUserId; выбор и проверки - на вызывающем
+func (s *Service) replaceReviewer(repo repository.Repository, pr models.PullRequest, oldUserId, newUserId, reason string) (models.PullRequest, error) {
+	prId := pr.PullRequestId
+	before := pullRequestEvent(pr)
+	pr.AssignedReviewers = slices.DeleteFunc(slices.Clone(pr.AssignedReviewers), func(id string) bool { return id == oldUserId })
+	// Поднимаем версию PR до изменения назначений, чтобы конкурирующий merge или reassign получил конфликт
+	if err := repo.UpdatePR(pr); err != nil {
+		return models.PullRequest{}, err
+	}
+	pr.Version++
+	now := s.now()
+	if err := repo.UnassignReviewer(prId, oldUserId, now); err != nil {
+		return models.PullRequest{}, err
+	}
+	err := repo.AssignReviewer(models.Assignment{
+		PullRequestId: prId,
+		UserId:        newUserId,
+		Role:          models.RoleReviewer,
+		AssignedAt:    now,
+	})
 	if err != nil {
-		return nil, err
+		return models.PullRequest{}, err
+	}
+	pr.AssignedReviewers = append(pr.AssignedReviewers, newUserId)
+	err = s.recordPR(repo,
+		models.PullRequestEvent{PullRequestId: prId, Type: models.PREventReviewerUnassigned, OccurredAt: now, UserId: oldUserId, Reason: reason},
+		models.PullRequestEvent{PullRequestId: prId, Type: models.PREventReviewerAssigned, OccurredAt: now, UserId: newUserId, Reason: reason},
+	)
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+	err = s.emit(repo, models.EventPRReviewerReassigned, reassignEventData{
+		PullRequest:   pullRequestEvent(pr),
+		OldReviewerId: oldUserId,
+		NewReviewerId: newUserId,
+	})
+	if err != nil {
+		return models.PullRequest{}, err
 	}
-	return s.storage.GetPRsByReviewer(userId)
+	entry, err := prAudit(repo, pr, models.AuditPRReviewerReassigned, reason, oldUserId, newUserId)
+	if err != nil {
+		return models.PullRequest{}, err
+	}
+	if err := s.audit(repo, entry, before, pullRequestEvent(pr)); err != nil {
+		return models.PullRequest{}, err
+	}
+	pr.ReviewerTeams = make(map[string]string, len(pr.AssignedReviewers))
+	for _, id := range pr.AssignedReviewers {
+		u, err := repo.GetUser(id)
+		if err != nil {
+			return models.PullRequest{}, err
+		}
+		pr.ReviewerTeams[id] = u.TeamName
+	}
+	return pr, nil
 }
 
-func (s *Service) GetAssignmentStats() ([]models.AssignmentStat, error) {
-	return s.storage.GetAssignmentStats()
+func (s *Service) GetTeamSettings(teamName string) (models.TeamSettings, error) {
+	return s.repo.GetTeamSettings(teamName)
 }
 
-func (s *Service) DeactivateTeam(teamName string) error {
-	users, err := s.storage.GetUsersByTeam(teamName)
+// SetTeamSettings применяет update к текущим настройкам команды и сохраняет результат в одной транзакции.
+// Настройки читаются внутри транзакции и пишутся с проверкой версии, поэтому параллельные частичные
+// изменения не затирают поля друг друга: проигравший запрос получает ErrConflict.
+func (s *Service) SetTeamSettings(teamName string, update func(settings *models.TeamSettings)) (models.TeamSettings, error) {
+	var settings models.TeamSettings
+	err := s.repo.WithTx(func(repo repository.Repository) error {
+		before, err := repo.GetTeamSettings(teamName)
+		if err != nil {
+			return err
+		}
+		settings = before
+		settings.FallbackTeams = slices.Clone(before.FallbackTeams)
+		update(&settings)
+		settings.TeamName, settings.Version = before.TeamName, before.Version
+		if err := validateTeamSettings(repo, settings); err != nil {
+			return err
+		}
+		if err := repo.UpdateTeamSettings(settings); err != nil {
+			return err
+		}
+		settings.Version++
+		return s.audit(repo, models.AuditEntry{
+			Action:     models.AuditTeamSettingsUpdated,
+			TargetType: models.AuditTargetTeam,
+			TargetId:   settings.TeamName,
+			TeamName:   settings.TeamName,
+		}, teamSettingsState(before), teamSettingsState(settings))
+	})
 	if err != nil {
-		return err
+		return models.TeamSettings{}, err
 	}
-	// Для каждого активного пользователя переназначить его PR
-	for _, user := range users {
-		if user.IsActive {
-			prs, err := s.storage.GetPRsByReviewer(user.UserId)
-			if err != nil {
-				continue
+	return settings, nil
+}
+
+func validateTeamSettings(repo repository.Repository, settings models.TeamSettings) error {
+	if settings.MinApprovals < 0 {
+		return errs.New(errs.ErrValidation, "min approvals must not be negative")
+	}
+	if settings.MinReviewers < 0 || settings.MaxReviewers < settings.MinReviewers {
+		return errs.New(errs.ErrValidation, "reviewer count bounds must satisfy 0 <= min_reviewers <= max_reviewers")
+	}
+	if settings.MaxOpenReviews < 0 {
+		return errs.New(errs.ErrValidation, "max open reviews must not be negative")
+	}
+	if settings.ReviewSLA < 0 || settings.ReassignAfter < 0 {
+		return errs.New(errs.ErrValidation, "review SLA thresholds must not be negative")
+	}
+	if settings.ReassignAfter > 0 && (settings.ReviewSLA == 0 || settings.ReassignAfter < settings.ReviewSLA) {
+		return errs.New(errs.ErrValidation, "auto-reassign threshold requires a review SLA and must not be shorter than it")
+	}
+	for i, team := range settings.FallbackTeams {
+		if team == settings.TeamName || contains(settings.FallbackTeams[:i], team) {
+			return errs.Newf(errs.ErrValidation, "fallback team %q is listed twice or is the team itself", team)
+		}
+		if _, err := repo.GetTeamSettings(team); err != nil {
+			if errors.Is(err, errs.ErrNotFound) {
+				return errs.Newf(errs.ErrValidation, "fallback team %q not found", team)
 			}
-			for _, prShort := range prs {
-				if prShort.Status == "OPEN" {
-					_, _, err := s.ReassignPR(prShort.PullRequestId, user.UserId)
-					if err != nil {
-						continue // Пропустить если переназначение невозможно
-					}
-				}
+			return err
+		}
+	}
+	if settings.ReviewerStrategy != "" {
+		if _, err := StrategyByName(settings.ReviewerStrategy); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) SetUserLead(userId string, isLead bool) (models.User, error) {
+	return s.SetUserSettings(userId, func(user *models.User) { user.IsLead = isLead })
+}
+
+func (s *Service) SetUserReviewWeight(userId string, weight int) (models.User, error) {
+	return s.SetUserSettings(userId, func(user *models.User) { user.ReviewWeight = weight })
+}
+
+func (s *Service) SetUserMaxOpenReviews(userId string, limit int) (models.User, error) {
+	return s.SetUserSettings(userId, func(user *models.User) { user.MaxOpenReviews = limit })
+}
+
+// SetUserSettings применяет update к настройкам пользователя (вес, лид, ограничение открытых ревью) в одной транзакции.
+// Все поля проверяются до записи; изменение попадает в журнал аудита и outbox одной записью.
+func (s *Service) SetUserSettings(userId string, update func(user *models.User)) (user models.User, err error) {
+	err = s.repo.WithTx(func(repo repository.Repository) error {
+		before, err := repo.GetUser(userId)
+		if err != nil {
+			return err
+		}
+		user = before
+		update(&user)
+		user.UserId, user.Username, user.TeamName, user.IsActive = before.UserId, before.Username, before.TeamName, before.IsActive
+		if user.ReviewWeight < 0 {
+			return errs.New(errs.ErrValidation, "review weight must not be negative")
+		}
+		if user.MaxOpenReviews < 0 {
+			return errs.New(errs.ErrValidation, "max open reviews must not be negative")
+		}
+		if user.ReviewWeight != before.ReviewWeight {
+			if err := repo.SetUserReviewWeight(userId, user.ReviewWeight); err != nil {
+				return err
+			}
+		}
+		if user.IsLead != before.IsLead {
+			if err := repo.SetUserLead(userId, user.IsLead); err != nil {
+				return err
+			}
+		}
+		if user.MaxOpenReviews != before.MaxOpenReviews {
+			if err := repo.SetUserMaxOpenReviews(userId, user.MaxOpenReviews); err != nil {
+				return err
+			}
+		}
+		if err := s.audit(repo, userAudit(user, models.AuditUserSettingsUpdated, models.AuditReasonManual), userState(before), userState(user)); err != nil {
+			return err
+		}
+		return s.emit(repo, models.EventUserSettingsUpdated, userSettingsEventData{
+			UserId:         user.UserId,
+			TeamName:       user.TeamName,
+			ReviewWeight:   user.ReviewWeight,
+			IsLead:         user.IsLead,
+			MaxOpenReviews: user.MaxOpenReviews,
+		})
+	})
+	if err != nil {
+		return models.User{}, err
+	}
+	return user, nil
+}
+
+// pickReviewers выбирает до n активных и доступных ревьюверов из команды teamName, а если кандидатов не хватает, добирает
+// их из запасных команд в порядке приоритета. Пользователи из exclude не выбираются.
+func (s *Service) pickReviewers(repo repository.Repository, teamName string, n int, exclude []string) ([]models.User, error) {
+	settings, err := repo.GetTeamSettings(teamName)
+	if err != nil {
+		return nil, err
+	}
+	exclude = append([]string(nil), exclude...)
+	var selected []models.User
+	for _, team := range append([]string{teamName}, settings.FallbackTeams...) {
+		if len(selected) >= n {
+			break
+		}
+		users, err := repo.GetUsersByTeam(team)
+		if err != nil {
+			return nil, err
+		}
+		var candidates []models.User
+		for _, u := range users {
+			if u.IsActive && !contains(exclude, u.UserId) {
+				candidates = append(candidates, u)
 			}
 		}
+		candidates, err = s.filterAvailable(repo, candidates)
+		if err != nil {
+			return nil, err
+		}
+		if len(candidates) == 0 {
+			continue
+		}
+		picked, err := s.selectReviewers(repo, team, candidates, n-len(selected))
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range picked {
+			selected = append(selected, u)
+			exclude = append(exclude, u.UserId)
+		}
+	}
+	return selected, nil
+}
+
+// selectReviewers выбирает ревьюверов стратегией команды или стратегией по умолчанию
+func (s *Service) selectReviewers(repo repository.Repository, teamName string, candidates []models.User, n int) ([]models.User, error) {
+	strategy := s.defaultStrategy
+	settings, err := repo.GetTeamSettings(teamName)
+	if err != nil {
+		return nil, err
+	}
+	if settings.ReviewerStrategy != "" {
+		if strategy, err = StrategyByName(settings.ReviewerStrategy); err != nil {
+			return nil, err
+		}
 	}
-	// Деактивировать всех пользователей команды
-	return s.storage.DeactivateTeam(teamName)
+	return strategy.Select(repo, teamName, candidates, n)
+}
+
+func (s *Service) GetPRsByReviewer(userId string) ([]models.PullRequestShort, error) {
+	_, err := s.repo.GetUser(userId)
+	if err != nil {
+		return nil, err
+	}
+	return s.repo.GetPRsByReviewer(userId)
+}
+
+func (s *Service) GetAssignmentStats() ([]models.AssignmentStat, error) {
+	return s.repo.GetAssignmentStats()
+}
+
+func (s *Service) GetReviewerLoads() ([]models.ReviewerLoad, error) {
+	return s.repo.GetReviewerLoads()
 }
@@ -1,201 +1,496 @@
 package service
 
 import (
+	"avito-internship/internal/errs"
 	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
 	"avito-internship/internal/storage"
+	"errors"
 	"testing"
 	"time"
 
-	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
-func TestService_AddTeam(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	require.NoError(t, err)
-	defer db.Close()
-
-	storage := &storage.Storage{DB: db}
-	svc := NewService(storage)
+// fakeRepo хранит данные в памяти и позволяет подменять ошибки отдельных методов
+type fakeRepo struct {
+	*storage.InMemStorage
+	assignErr error
+}
 
-	teamName := "test-team"
-	members := []models.TeamMember{
-		{UserId: "1", Username: "user1", IsActive: true},
-		{UserId: "2", Username: "user2", IsActive: false},
+func (f *fakeRepo) AssignReviewer(assignment models.Assignment) error {
+	if f.assignErr != nil {
+		return f.assignErr
 	}
+	return f.InMemStorage.AssignReviewer(assignment)
+}
 
-	mock.ExpectBegin()
-	mock.ExpectExec("INSERT INTO teams").WithArgs(teamName).WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectExec("INSERT INTO users").WithArgs("1", "user1", teamName, true).WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectExec("INSERT INTO users").WithArgs("2", "user2", teamName, false).WillReturnResult(sqlmock.NewResult(1, 1))
-	mock.ExpectCommit()
+func (f *fakeRepo) WithTx(fn func(repo repository.Repository) error) error {
+	return f.InMemStorage.WithTx(func(tx repository.Repository) error {
+		return fn(&fakeRepo{InMemStorage: tx.(*storage.InMemStorage), assignErr: f.assignErr})
+	})
+}
 
-	err = svc.AddTeam(teamName, members)
-	assert.NoError(t, err)
-	assert.NoError(t, mock.ExpectationsWereMet())
+func newTestService(t *testing.T, teams map[string][]models.TeamMember) (*Service, *fakeRepo) {
+	t.Helper()
+	repo := newFakeRepo(t, teams)
+	return NewService(repo), repo
 }
 
-func TestService_GetTeam(t *testing.T) {
-	db, mock, err := sqlmock.New()
-	require.NoError(t, err)
-	defer db.Close()
+func newFakeRepo(t *testing.T, teams map[string][]models.TeamMember) *fakeRepo {
+	t.Helper()
+	repo := &fakeRepo{InMemStorage: storage.NewInMemStorage()}
+	for name, members := range teams {
+		require.NoError(t, repo.AddTeam(name, members))
+	}
+	return repo
+}
+
+func addOpenPR(t *testing.T, repo *fakeRepo, prId, authorId string, reviewers ...string) {
+	t.Helper()
+	now := time.Now()
+	require.NoError(t, repo.CreatePR(models.PullRequest{
+		PullRequestId:     prId,
+		PullRequestName:   prId,
+		AuthorId:          authorId,
+		Status:            "OPEN",
+		AssignedReviewers: reviewers,
+		CreatedAt:         &now,
+	}))
+}
 
-	storage := &storage.Storage{DB: db}
-	svc := NewService(storage)
+func TestService_AddTeam(t *testing.T) {
+	svc, _ := newTestService(t, nil)
 
-	teamName := "test-team"
-	expectedMembers := []models.TeamMember{
+	members := []models.TeamMember{
 		{UserId: "1", Username: "user1", IsActive: true},
 		{UserId: "2", Username: "user2", IsActive: false},
 	}
+	require.NoError(t, svc.AddTeam("test-team", members))
+	assert.Error(t, svc.AddTeam("test-team", members))
 
-	rows := sqlmock.NewRows([]string{"user_id", "username", "is_active"}).
-		AddRow("1", "user1", true).
-		AddRow("2", "user2", false)
-	mock.ExpectQuery("SELECT user_id, username, is_active FROM users WHERE team_name = \\$1").WithArgs(teamName).WillReturnRows(rows)
-
-	members, err := svc.GetTeam(teamName)
+	got, err := svc.GetTeam("test-team")
 	assert.NoError(t, err)
-	assert.Equal(t, expectedMembers, members)
-	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, members, got)
 }
 
 func TestService_CreatePR(t *testing.T) {
-	db, mock, err := sqlmock.New()
+	svc, _ := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+			{UserId: "inactive", Username: "inactive", IsActive: false},
+		},
+	})
+
+	pr, err := svc.CreatePR("pr1", "Test PR", "author1", nil, nil)
 	require.NoError(t, err)
-	defer db.Close()
+	assert.Equal(t, "pr1", pr.PullRequestId)
+	assert.Equal(t, "Test PR", pr.PullRequestName)
+	assert.Equal(t, "author1", pr.AuthorId)
+	assert.Equal(t, "OPEN", pr.Status)
+	assert.Len(t, pr.AssignedReviewers, 2)
+	assert.NotContains(t, pr.AssignedReviewers, "author1")
+	assert.NotContains(t, pr.AssignedReviewers, "inactive")
+
+	_, err = svc.CreatePR("pr1", "Test PR", "author1", nil, nil)
+	assert.Error(t, err)
+	_, err = svc.CreatePR("pr2", "Test PR", "unknown", nil, nil)
+	assert.EqualError(t, err, "user not found")
+}
+
+func TestService_CreatePR_NotEnoughCandidates(t *testing.T) {
+	svc, _ := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: false},
+		},
+	})
 
-	storage := &storage.Storage{DB: db}
-	svc := NewService(storage)
+	pr, err := svc.CreatePR("pr1", "Test PR", "author1", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev1"}, pr.AssignedReviewers)
+}
 
-	prId := "pr1"
-	prName := "Test PR"
-	authorId := "author1"
+func TestService_CreatePR_ReviewerCount(t *testing.T) {
+	svc, _ := newTestService(t, map[string][]models.TeamMember{
+		"security": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+		},
+	})
+	_, err := svc.SetTeamSettings("security", func(settings *models.TeamSettings) {
+		settings.MinReviewers = 3
+		settings.MaxReviewers = 2
+	})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SetTeamSettings("security", func(settings *models.TeamSettings) {
+		settings.MinReviewers = 1
+		settings.MaxReviewers = 3
+	})
+	require.NoError(t, err)
 
-	authorRows := sqlmock.NewRows([]string{"user_id", "username", "team_name", "is_active"}).
-		AddRow("author1", "author", "team1", true)
-	mock.ExpectQuery("SELECT user_id, username, team_name, is_active FROM users WHERE user_id = \\$1").WithArgs(authorId).WillReturnRows(authorRows)
+	pr, err := svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	assert.Len(t, pr.AssignedReviewers, 3)
+	assert.False(t, pr.UnderReviewed)
 
-	teamRows := sqlmock.NewRows([]string{"user_id", "username", "team_name", "is_active"}).
-		AddRow("rev1", "rev1", "team1", true).
-		AddRow("rev2", "rev2", "team1", true)
-	mock.ExpectQuery("SELECT user_id, username, team_name, is_active FROM users WHERE team_name = \\$1").WithArgs("team1").WillReturnRows(teamRows)
+	one := 1
+	pr, err = svc.CreatePR("pr2", "PR", "author1", &one, nil)
+	require.NoError(t, err)
+	assert.Len(t, pr.AssignedReviewers, 1)
 
-	mock.ExpectExec("INSERT INTO pull_requests").WithArgs(prId, prName, authorId, "OPEN", sqlmock.AnyArg(), sqlmock.AnyArg()).WillReturnResult(sqlmock.NewResult(1, 1))
+	four := 4
+	_, err = svc.CreatePR("pr3", "PR", "author1", &four, nil)
+	assert.ErrorIs(t, err, errs.ErrValidation)
 
-	pr, err := svc.CreatePR(prId, prName, authorId)
-	assert.NoError(t, err)
-	assert.Equal(t, prId, pr.PullRequestId)
-	assert.Equal(t, prName, pr.PullRequestName)
-	assert.Equal(t, authorId, pr.AuthorId)
-	assert.Equal(t, "OPEN", pr.Status)
+	// Команда не может дать минимум ревьюверов: PR создаётся, но помечается
+	_, _, err = svc.SetUserActive("rev3", false, false, false)
+	require.NoError(t, err)
+	_, err = svc.SetTeamSettings("security", func(settings *models.TeamSettings) {
+		settings.MinReviewers = 3
+		settings.MaxReviewers = 3
+	})
+	require.NoError(t, err)
+	pr, err = svc.CreatePR("pr4", "PR", "author1", nil, nil)
+	require.NoError(t, err)
 	assert.Len(t, pr.AssignedReviewers, 2)
-	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.True(t, pr.UnderReviewed)
 }
 
-func TestService_MergePR(t *testing.T) {
-	db, mock, err := sqlmock.New()
+func TestService_FallbackTeams(t *testing.T) {
+	svc, _ := newTestService(t, map[string][]models.TeamMember{
+		"small": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: false},
+		},
+		"empty":    {{UserId: "idle1", Username: "idle1", IsActive: false}},
+		"platform": {{UserId: "plat1", Username: "plat1", IsActive: true}},
+		"backend": {
+			{UserId: "back1", Username: "back1", IsActive: true},
+			{UserId: "back2", Username: "back2", IsActive: true},
+		},
+	})
+	_, err := svc.SetTeamSettings("small", func(settings *models.TeamSettings) { settings.FallbackTeams = []string{"unknown"} })
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SetTeamSettings("small", func(settings *models.TeamSettings) { settings.FallbackTeams = []string{"small"} })
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SetTeamSettings("small", func(settings *models.TeamSettings) {
+		settings.FallbackTeams = []string{"empty", "platform", "backend"}
+	})
 	require.NoError(t, err)
-	defer db.Close()
-
-	storage := &storage.Storage{DB: db}
-	svc := NewService(storage)
 
-	prId := "pr1"
-	createdAt := time.Now()
+	pr, err := svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	require.Len(t, pr.AssignedReviewers, 2)
+	assert.Equal(t, "plat1", pr.AssignedReviewers[0])
+	assert.Equal(t, map[string]string{"plat1": "platform", pr.AssignedReviewers[1]: "backend"}, pr.ReviewerTeams)
 
-	prRows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at"}).
-		AddRow(prId, "Test PR", "author1", "OPEN", `["rev1", "rev2"]`, createdAt, nil)
-	mock.ExpectQuery("SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at FROM pull_requests WHERE pull_request_id = \\$1").WithArgs(prId).WillReturnRows(prRows)
+	// Замена для ревьювера из запасной команды ищется по запасным командам его собственной команды
+	_, err = svc.SetTeamSettings("platform", func(settings *models.TeamSettings) { settings.FallbackTeams = []string{"backend"} })
+	require.NoError(t, err)
+	pr, newReviewer, err := svc.ReassignPR("pr1", "plat1")
+	require.NoError(t, err)
+	assert.Equal(t, "backend", pr.ReviewerTeams[newReviewer])
+	assert.Len(t, pr.ReviewerTeams, 2)
+}
 
-	mock.ExpectExec("UPDATE pull_requests SET pull_request_name = \\$1, author_id = \\$2, status = \\$3, assigned_reviewers = \\$4, merged_at = \\$5 WHERE pull_request_id = \\$6").
-		WithArgs("Test PR", "author1", "MERGED", sqlmock.AnyArg(), sqlmock.AnyArg(), prId).WillReturnResult(sqlmock.NewResult(1, 1))
+func TestService_MergePR(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {{UserId: "author1", Username: "author", IsActive: true}},
+	})
+	addOpenPR(t, repo, "pr1", "author1")
 
-	pr, err := svc.MergePR(prId)
-	assert.NoError(t, err)
+	pr, err := svc.MergePR("pr1", false)
+	require.NoError(t, err)
 	assert.Equal(t, "MERGED", pr.Status)
-	assert.NotNil(t, pr.MergedAt)
-	assert.NoError(t, mock.ExpectationsWereMet())
+	require.NotNil(t, pr.MergedAt)
+
+	again, err := svc.MergePR("pr1", false)
+	require.NoError(t, err)
+	assert.Equal(t, pr.MergedAt.Unix(), again.MergedAt.Unix())
+
+	_, err = svc.MergePR("unknown", false)
+	assert.EqualError(t, err, "PR not found")
 }
 
 func TestService_ReassignPR(t *testing.T) {
-	db, mock, err := sqlmock.New()
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+		},
+	})
+	addOpenPR(t, repo, "pr1", "author1", "rev1", "rev2")
+
+	pr, newReviewer, err := svc.ReassignPR("pr1", "rev1")
 	require.NoError(t, err)
-	defer db.Close()
+	assert.Equal(t, "rev3", newReviewer)
+	assert.ElementsMatch(t, []string{"rev2", "rev3"}, pr.AssignedReviewers)
 
-	storage := &storage.Storage{DB: db}
-	svc := NewService(storage)
+	stored, err := repo.GetPR("pr1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"rev2", "rev3"}, stored.AssignedReviewers)
 
-	prId := "pr1"
-	oldUserId := "rev1"
-	createdAt := time.Now()
+	// Снятое назначение остаётся в истории с временем снятия
+	assignments, err := repo.GetAssignments("pr1")
+	require.NoError(t, err)
+	require.Len(t, assignments, 3)
+	assert.Equal(t, "rev1", assignments[0].UserId)
+	assert.NotNil(t, assignments[0].UnassignedAt)
+	assert.Equal(t, "rev3", assignments[2].UserId)
+	assert.Nil(t, assignments[2].UnassignedAt)
+}
 
-	prRows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status", "assigned_reviewers", "created_at", "merged_at"}).
-		AddRow(prId, "Test PR", "author1", "OPEN", `["rev1", "rev2"]`, createdAt, nil)
-	mock.ExpectQuery("SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at FROM pull_requests WHERE pull_request_id = \\$1").WithArgs(prId).WillReturnRows(prRows)
+func TestService_ReassignPR_Errors(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+		},
+	})
+	addOpenPR(t, repo, "pr1", "author1", "rev1", "rev2")
 
-	userRows := sqlmock.NewRows([]string{"user_id", "username", "team_name", "is_active"}).
-		AddRow("rev1", "rev1", "team1", true)
-	mock.ExpectQuery("SELECT user_id, username, team_name, is_active FROM users WHERE user_id = \\$1").WithArgs(oldUserId).WillReturnRows(userRows)
+	_, _, err := svc.ReassignPR("pr1", "author1")
+	assert.EqualError(t, err, "reviewer is not assigned to this PR")
 
-	teamRows := sqlmock.NewRows([]string{"user_id", "username", "team_name", "is_active"}).
-		AddRow("rev3", "rev3", "team1", true)
-	mock.ExpectQuery("SELECT user_id, username, team_name, is_active FROM users WHERE team_name = \\$1").WithArgs("team1").WillReturnRows(teamRows)
+	// Автор, второй ревьювер и сам заменяемый не могут быть кандидатами
+	_, _, err = svc.ReassignPR("pr1", "rev1")
+	assert.EqualError(t, err, "no active replacement candidate in team")
 
-	mock.ExpectExec("UPDATE pull_requests SET pull_request_name = \\$1, author_id = \\$2, status = \\$3, assigned_reviewers = \\$4, merged_at = \\$5 WHERE pull_request_id = \\$6").
-		WithArgs("Test PR", "author1", "OPEN", sqlmock.AnyArg(), nil, prId).WillReturnResult(sqlmock.NewResult(1, 1))
+	_, err = svc.MergePR("pr1", false)
+	require.NoError(t, err)
+	_, _, err = svc.ReassignPR("pr1", "rev1")
+	assert.EqualError(t, err, "cannot reassign on merged PR")
 
-	pr, newReviewer, err := svc.ReassignPR(prId, oldUserId)
-	assert.NoError(t, err)
-	assert.Equal(t, "OPEN", pr.Status)
-	assert.Contains(t, pr.AssignedReviewers, "rev2")
-	assert.Contains(t, pr.AssignedReviewers, newReviewer)
-	assert.NoError(t, mock.ExpectationsWereMet())
+	_, _, err = svc.ReassignPR("unknown", "rev1")
+	assert.EqualError(t, err, "PR not found")
 }
 
-func TestService_DeactivateTeam(t *testing.T) {
-	db, mock, err := sqlmock.New()
+func TestService_SetUserActive(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+		},
+	})
+	addOpenPR(t, repo, "pr1", "author1", "rev1", "rev2")
+	addOpenPR(t, repo, "pr2", "rev2", "rev1", "rev3", "author1")
+	addOpenPR(t, repo, "pr3", "author1", "rev1")
+	_, err := svc.MergePR("pr3", true)
 	require.NoError(t, err)
-	defer db.Close()
-
-	storage := &storage.Storage{DB: db}
-	svc := NewService(storage)
 
-	teamName := "test-team"
+	want := models.DeactivationReport{
+		TeamName:         "team1",
+		DryRun:           true,
+		DeactivatedUsers: []string{"rev1"},
+		Reassignments: []models.Reassignment{
+			{PullRequestId: "pr1", OldReviewerId: "rev1", NewReviewerId: "rev3"},
+			// Автор и остальные ревьюверы исключаются, других кандидатов нет
+			{PullRequestId: "pr2", OldReviewerId: "rev1", Reason: "no active replacement candidate in team"},
+		},
+		UnderReviewed: []models.UnderReviewedPR{},
+	}
+	user, plan, err := svc.SetUserActive("rev1", false, true, false)
+	require.NoError(t, err)
+	assert.False(t, user.IsActive)
+	assert.Equal(t, want, plan)
+	stored, err := repo.GetUser("rev1")
+	require.NoError(t, err)
+	assert.True(t, stored.IsActive)
+	pr, err := repo.GetPR("pr1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"rev1", "rev2"}, pr.AssignedReviewers)
 
-	usersRows := sqlmock.NewRows([]string{"user_id", "username", "team_name", "is_active"}).
-		AddRow("user1", "user1", teamName, true).
-		AddRow("user2", "user2", teamName, false)
-	mock.ExpectQuery("SELECT user_id, username, team_name, is_active FROM users WHERE team_name = \\$1").WithArgs(teamName).WillReturnRows(usersRows)
+	_, report, err := svc.SetUserActive("rev1", false, false, false)
+	require.NoError(t, err)
+	want.DryRun = false
+	assert.Equal(t, want, report)
+	stored, err = repo.GetUser("rev1")
+	require.NoError(t, err)
+	assert.False(t, stored.IsActive)
+	pr, err = repo.GetPR("pr1")
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"rev2", "rev3"}, pr.AssignedReviewers)
 
-	mock.ExpectExec("UPDATE users SET is_active = false WHERE team_name = \\$1").WithArgs(teamName).WillReturnResult(sqlmock.NewResult(0, 2))
+	// Повторная деактивация и активация ничего не переназначают
+	_, report, err = svc.SetUserActive("rev1", false, false, false)
+	require.NoError(t, err)
+	assert.Empty(t, report.DeactivatedUsers)
+	assert.Empty(t, report.Reassignments)
+	user, report, err = svc.SetUserActive("rev1", true, false, false)
+	require.NoError(t, err)
+	assert.True(t, user.IsActive)
+	assert.Empty(t, report.Reassignments)
 
-	err = svc.DeactivateTeam(teamName)
-	assert.NoError(t, err)
-	assert.NoError(t, mock.ExpectationsWereMet())
+	_, _, err = svc.SetUserActive("unknown", false, true, false)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
 }
 
-func TestService_GetAssignmentStats(t *testing.T) {
-	db, mock, err := sqlmock.New()
+func TestService_DeactivateTeam(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "user1", Username: "user1", IsActive: true},
+			{UserId: "user2", Username: "user2", IsActive: false},
+			{UserId: "user3", Username: "user3", IsActive: true},
+		},
+		"team2": {
+			{UserId: "author1", Username: "author", IsActive: true},
+		},
+		"team3": {
+			{UserId: "backup1", Username: "backup1", IsActive: true},
+		},
+	})
+	_, err := svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.FallbackTeams = []string{"team3"} })
+	require.NoError(t, err)
+	addOpenPR(t, repo, "pr1", "author1", "user1")
+	// Единственный кандидат резервной команды - автор
+	addOpenPR(t, repo, "pr2", "backup1", "user3")
+
+	want := models.DeactivationReport{
+		TeamName:         "team1",
+		DryRun:           true,
+		DeactivatedUsers: []string{"user1", "user3"},
+		Reassignments: []models.Reassignment{
+			{PullRequestId: "pr1", OldReviewerId: "user1", NewReviewerId: "backup1"},
+			{PullRequestId: "pr2", OldReviewerId: "user3", Reason: "no active replacement candidate in team"},
+		},
+		UnderReviewed: []models.UnderReviewedPR{{PullRequestId: "pr2", ActiveReviewers: []string{}}},
+	}
+	plan, err := svc.DeactivateTeam("team1", true)
+	require.NoError(t, err)
+	assert.Equal(t, want, plan)
+	user, err := repo.GetUser("user1")
+	require.NoError(t, err)
+	assert.True(t, user.IsActive)
+	pr, err := repo.GetPR("pr1")
 	require.NoError(t, err)
-	defer db.Close()
+	assert.Equal(t, []string{"user1"}, pr.AssignedReviewers)
+	failures, err := svc.GetAuditLog(models.AuditFilter{PullRequestId: "pr2"})
+	require.NoError(t, err)
+	assert.Empty(t, failures)
 
-	storage := &storage.Storage{DB: db}
-	svc := NewService(storage)
+	report, err := svc.DeactivateTeam("team1", false)
+	require.NoError(t, err)
+	want.DryRun = false
+	assert.Equal(t, want, report)
+	users, err := repo.GetUsersByTeam("team1")
+	require.NoError(t, err)
+	for _, u := range users {
+		assert.False(t, u.IsActive)
+	}
+	pr, err = repo.GetPR("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"backup1"}, pr.AssignedReviewers)
+	// Без замены назначение остаётся как было
+	pr, err = repo.GetPR("pr2")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"user3"}, pr.AssignedReviewers)
+	failures, err = svc.GetAuditLog(models.AuditFilter{PullRequestId: "pr2"})
+	require.NoError(t, err)
+	require.Len(t, failures, 1)
+	assert.Equal(t, models.AuditPRReassignFailed, failures[0].Action)
+
+	_, err = svc.DeactivateTeam("unknown", false)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
 
-	expectedStats := []models.AssignmentStat{
-		{UserId: "user1", Count: 5},
-		{UserId: "user2", Count: 3},
+func TestService_DeactivateTeam_ReassignFailure(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+		},
+		"team2": {
+			{UserId: "backup1", Username: "backup1", IsActive: true},
+		},
+	})
+	_, err := svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.FallbackTeams = []string{"team2"} })
+	require.NoError(t, err)
+	addOpenPR(t, repo, "pr1", "author1", "rev1")
+	repo.assignErr = errors.New("assign failed")
+
+	// Ошибка замены откатывает всю деактивацию
+	_, err = svc.DeactivateTeam("team1", false)
+	assert.EqualError(t, err, "assign failed")
+	u, err := repo.GetUser("rev1")
+	require.NoError(t, err)
+	assert.True(t, u.IsActive)
+	pr, err := repo.GetPR("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev1"}, pr.AssignedReviewers)
+	entries, err := svc.GetAuditLog(models.AuditFilter{TeamName: "team1"})
+	require.NoError(t, err)
+	for _, e := range entries {
+		assert.Equal(t, models.AuditTeamSettingsUpdated, e.Action)
 	}
+}
 
-	rows := sqlmock.NewRows([]string{"user_id", "count"}).
-		AddRow("user1", 5).
-		AddRow("user2", 3)
-	mock.ExpectQuery("SELECT elem AS user_id, COUNT\\(\\*\\) AS count FROM pull_requests pr CROSS JOIN jsonb_array_elements_text\\(pr.assigned_reviewers\\) AS elem GROUP BY elem ORDER BY count DESC").WillReturnRows(rows)
+func TestService_GetAssignmentStats(t *testing.T) {
+	svc, repo := newTestService(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "user1", Username: "user1", IsActive: true},
+			{UserId: "user2", Username: "user2", IsActive: true},
+		},
+	})
+	addOpenPR(t, repo, "pr1", "author1", "user1", "user2")
+	addOpenPR(t, repo, "pr2", "author1", "user1")
 
 	stats, err := svc.GetAssignmentStats()
 	assert.NoError(t, err)
-	assert.Equal(t, expectedStats, stats)
-	assert.NoError(t, mock.ExpectationsWereMet())
+	assert.Equal(t, []models.AssignmentStat{
+		{UserId: "user1", Count: 2},
+		{UserId: "user2", Count: 1},
+	}, stats)
+}
+
+type recordedMetrics struct {
+	created, merged int
+	outcomes        []string
+	reassigned      int
+}
+
+func (m *recordedMetrics) PRCreated()          { m.created++ }
+func (m *recordedMetrics) PRMerged()           { m.merged++ }
+func (m *recordedMetrics) Reassigned(o string) { m.outcomes = append(m.outcomes, o) }
+func (m *recordedMetrics) TeamDeactivated(_ time.Duration, reassigned int) {
+	m.reassigned += reassigned
+}
+
+func TestService_Metrics(t *testing.T) {
+	repo := &fakeRepo{InMemStorage: storage.NewInMemStorage()}
+	require.NoError(t, repo.AddTeam("team1", []models.TeamMember{
+		{UserId: "author1", Username: "author", IsActive: true},
+		{UserId: "rev1", Username: "rev1", IsActive: true},
+		{UserId: "rev2", Username: "rev2", IsActive: true},
+	}))
+	metrics := &recordedMetrics{}
+	svc := NewService(repo, WithMetrics(metrics))
+
+	_, err := svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	_, _, err = svc.ReassignPR("pr1", "author1")
+	assert.Error(t, err)
+	_, _, err = svc.ReassignPR("pr1", "rev1")
+	assert.Error(t, err)
+	_, err = svc.MergePR("pr1", false)
+	require.NoError(t, err)
+	_, err = svc.MergePR("pr1", false)
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, metrics.created)
+	assert.Equal(t, 1, metrics.merged)
+	assert.Equal(t, []string{"NOT_ASSIGNED", "NO_CANDIDATE"}, metrics.outcomes)
 }
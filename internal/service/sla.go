@@ -0,0 +1,150 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"errors"
+	"time"
+)
+
+// overdueAssignment - неотвеченное назначение вместе с PR и настройками SLA команды его автора
+type overdueAssignment struct {
+	assignment models.Assignment
+	pr         models.PullRequest
+	settings   models.TeamSettings
+}
+
+// overdueAssignments возвращает неотвеченные назначения, срок SLA которых истёк к моменту now
+func (s *Service) overdueAssignments(repo repository.Repository, now time.Time) ([]overdueAssignment, error) {
+	assignments, err := repo.GetUnansweredAssignments()
+	if err != nil {
+		return nil, err
+	}
+	prs := make(map[string]models.PullRequest)
+	teams := make(map[string]models.TeamSettings)
+	var overdue []overdueAssignment
+	for _, a := range assignments {
+		pr, ok := prs[a.PullRequestId]
+		if !ok {
+			if pr, err = repo.GetPR(a.PullRequestId); err != nil {
+				return nil, err
+			}
+			prs[a.PullRequestId] = pr
+		}
+		author, err := repo.GetUser(pr.AuthorId)
+		if err != nil {
+			return nil, err
+		}
+		settings, ok := teams[author.TeamName]
+		if !ok {
+			if settings, err = repo.GetTeamSettings(author.TeamName); err != nil {
+				return nil, err
+			}
+			teams[author.TeamName] = settings
+		}
+		if settings.ReviewSLA <= 0 || now.Sub(a.AssignedAt) < settings.ReviewSLA {
+			continue
+		}
+		overdue = append(overdue, overdueAssignment{assignment: a, pr: pr, settings: settings})
+	}
+	return overdue, nil
+}
+
+// GetOverduePRs возвращает открытые PR, ревьюверы которых не ответили в срок SLA команды автора.
+// Пустой teamName означает все команды.
+func (s *Service) GetOverduePRs(teamName string) ([]models.OverduePR, error) {
+	if teamName != "" {
+		if _, err := s.repo.GetTeamSettings(teamName); err != nil {
+			return nil, err
+		}
+	}
+	overdue, err := s.overdueAssignments(s.repo, s.now())
+	if err != nil {
+		return nil, err
+	}
+	var result []models.OverduePR
+	index := make(map[string]int)
+	for _, o := range overdue {
+		if teamName != "" && o.settings.TeamName != teamName {
+			continue
+		}
+		i, ok := index[o.pr.PullRequestId]
+		if !ok {
+			i = len(result)
+			index[o.pr.PullRequestId] = i
+			result = append(result, models.OverduePR{
+				PullRequest: models.PullRequestShort{
+					PullRequestId:   o.pr.PullRequestId,
+					PullRequestName: o.pr.PullRequestName,
+					AuthorId:        o.pr.AuthorId,
+					Status:          o.pr.Status,
+				},
+				TeamName: o.settings.TeamName,
+			})
+		}
+		reviewer := models.OverdueReviewer{
+			UserId:     o.assignment.UserId,
+			AssignedAt: o.assignment.AssignedAt,
+			DueAt:      o.assignment.AssignedAt.Add(o.settings.ReviewSLA),
+		}
+		if o.settings.ReassignAfter > 0 {
+			reassignAt := o.assignment.AssignedAt.Add(o.settings.ReassignAfter)
+			reviewer.ReassignAt = &reassignAt
+		}
+		result[i].Reviewers = append(result[i].Reviewers, reviewer)
+	}
+	return result, nil
+}
+
+// ProcessReviewSLA отмечает просроченные назначения и заменяет ревьюверов, которые молчат дольше ReassignAfter.
+// Назначения, для которых нет замены, остаются просроченными; следующая попытка замены - не раньше чем через ReassignAfter.
+func (s *Service) ProcessReviewSLA() (marked, reassigned int, err error) {
+	now := s.now()
+	overdue, err := s.overdueAssignments(s.repo, now)
+	if err != nil {
+		return 0, 0, err
+	}
+	for _, o := range overdue {
+		a := o.assignment
+		if a.OverdueAt == nil {
+			err := s.repo.WithTx(func(repo repository.Repository) error {
+				if err := repo.MarkAssignmentOverdue(a.PullRequestId, a.UserId, now); err != nil {
+					return err
+				}
+				entry, err := prAudit(repo, o.pr, models.AuditPRReviewerOverdue, models.AuditReasonSLA, a.UserId)
+				if err != nil {
+					return err
+				}
+				return s.audit(repo, entry, nil, assignmentSnapshot{UserId: a.UserId, AssignedAt: a.AssignedAt, OverdueAt: now})
+			})
+			if err != nil {
+				return marked, reassigned, err
+			}
+			marked++
+		}
+		if o.settings.ReassignAfter <= 0 || now.Sub(a.AssignedAt) < o.settings.ReassignAfter {
+			continue
+		}
+		if a.ReassignFailedAt != nil && now.Sub(*a.ReassignFailedAt) < o.settings.ReassignAfter {
+			continue
+		}
+		_, _, reassignErr := s.reassign(a.PullRequestId, a.UserId, models.AuditReasonSLA)
+		if reassignErr == nil {
+			reassigned++
+			continue
+		}
+		err := s.repo.WithTx(func(repo repository.Repository) error {
+			if errors.Is(reassignErr, errs.ErrNoCandidate) {
+				if err := repo.MarkReassignFailed(a.PullRequestId, a.UserId, now); err != nil {
+					return err
+				}
+			}
+			return s.auditReassignFailure(repo, a.PullRequestId, a.UserId, models.AuditReasonSLA, reassignErr)
+		})
+		if err != nil {
+			return marked, reassigned, err
+		}
+	}
+	return marked, reassigned, nil
+}
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_ReviewSLA(t *testing.T) {
+	now := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+		},
+		"team2": {
+			{UserId: "author2", Username: "author2", IsActive: true},
+			{UserId: "rev4", Username: "rev4", IsActive: true},
+		},
+	})
+	svc := NewService(repo, WithClock(func() time.Time { return now }))
+
+	_, err := svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.ReassignAfter = time.Hour })
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SetTeamSettings("team1", func(settings *models.TeamSettings) {
+		settings.ReviewSLA = 2 * time.Hour
+		settings.ReassignAfter = time.Hour
+	})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.SetTeamSettings("team1", func(settings *models.TeamSettings) {
+		settings.ReviewSLA = 2 * time.Hour
+		settings.ReassignAfter = 4 * time.Hour
+	})
+	require.NoError(t, err)
+
+	pr, err := svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	require.Len(t, pr.AssignedReviewers, 2)
+	answered, silent := pr.AssignedReviewers[0], pr.AssignedReviewers[1]
+	// У team2 SLA не задан, её PR никогда не просрочен
+	_, err = svc.CreatePR("pr2", "PR", "author2", nil, nil)
+	require.NoError(t, err)
+
+	now = now.Add(time.Hour)
+	_, err = svc.SubmitReview("pr1", answered, models.ReviewCommented, "looking")
+	require.NoError(t, err)
+	overdue, err := svc.GetOverduePRs("")
+	require.NoError(t, err)
+	assert.Empty(t, overdue)
+
+	now = now.Add(2 * time.Hour)
+	overdue, err = svc.GetOverduePRs("")
+	require.NoError(t, err)
+	require.Len(t, overdue, 1)
+	assert.Equal(t, "pr1", overdue[0].PullRequest.PullRequestId)
+	assert.Equal(t, "team1", overdue[0].TeamName)
+	require.Len(t, overdue[0].Reviewers, 1)
+	assert.Equal(t, silent, overdue[0].Reviewers[0].UserId)
+	assert.Equal(t, overdue[0].Reviewers[0].AssignedAt.Add(2*time.Hour), overdue[0].Reviewers[0].DueAt)
+	overdue, err = svc.GetOverduePRs("team2")
+	require.NoError(t, err)
+	assert.Empty(t, overdue)
+	_, err = svc.GetOverduePRs("unknown")
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+
+	marked, reassigned, err := svc.ProcessReviewSLA()
+	require.NoError(t, err)
+	assert.Equal(t, 1, marked)
+	assert.Equal(t, 0, reassigned)
+	marked, _, err = svc.ProcessReviewSLA()
+	require.NoError(t, err)
+	assert.Equal(t, 0, marked)
+
+	now = now.Add(time.Hour)
+	_, reassigned, err = svc.ProcessReviewSLA()
+	require.NoError(t, err)
+	assert.Equal(t, 1, reassigned)
+	details, err := svc.GetPRDetails("pr1")
+	require.NoError(t, err)
+	assert.Contains(t, details.PullRequest.AssignedReviewers, answered)
+	assert.NotContains(t, details.PullRequest.AssignedReviewers, silent)
+
+	// Новый ревьювер получает полный срок SLA с момента назначения
+	overdue, err = svc.GetOverduePRs("team1")
+	require.NoError(t, err)
+	assert.Empty(t, overdue)
+}
+
+func TestService_ReviewSLA_NoCandidate(t *testing.T) {
+	now := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: false},
+		},
+	})
+	metrics := &recordedMetrics{}
+	svc := NewService(repo, WithClock(func() time.Time { return now }), WithMetrics(metrics))
+	_, err := svc.SetTeamSettings("team1", func(settings *models.TeamSettings) {
+		settings.ReviewSLA = time.Hour
+		settings.ReassignAfter = 2 * time.Hour
+	})
+	require.NoError(t, err)
+	_, err = svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	failures := func() int {
+		entries, err := svc.GetAuditLog(models.AuditFilter{PullRequestId: "pr1"})
+		require.NoError(t, err)
+		n := 0
+		for _, e := range entries {
+			if e.Action == models.AuditPRReassignFailed {
+				assert.Equal(t, models.AuditReasonSLA, e.Reason)
+				n++
+			}
+		}
+		return n
+	}
+
+	now = now.Add(3 * time.Hour)
+	_, reassigned, err := svc.ProcessReviewSLA()
+	require.NoError(t, err)
+	assert.Equal(t, 0, reassigned)
+	assert.Equal(t, 1, failures())
+	assert.Equal(t, []string{"NO_CANDIDATE"}, metrics.outcomes)
+
+	// Пока не прошёл ещё один интервал ReassignAfter, замена не повторяется
+	now = now.Add(time.Hour)
+	_, _, err = svc.ProcessReviewSLA()
+	require.NoError(t, err)
+	assert.Equal(t, 1, failures())
+	assert.Equal(t, []string{"NO_CANDIDATE"}, metrics.outcomes)
+
+	// Через ReassignAfter после неудачи замена повторяется и находит появившегося кандидата
+	require.NoError(t, repo.SetUserActive("rev2", true))
+	now = now.Add(time.Hour)
+	_, reassigned, err = svc.ProcessReviewSLA()
+	require.NoError(t, err)
+	assert.Equal(t, 1, reassigned)
+	pr, err := repo.GetPR("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev2"}, pr.AssignedReviewers)
+}
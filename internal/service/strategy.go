@@ -0,0 +1,132 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"math/rand"
+	"sort"
+)
+
+const (
+	StrategyRandom      = "random"
+	StrategyLeastLoaded = "least_loaded"
+	StrategyRoundRobin  = "round_robin"
+	StrategyWeighted    = "weighted"
+)
+
+// ReviewerStrategy выбирает до n ревьюверов из уже отфильтрованных кандидатов команды
+type ReviewerStrategy interface {
+	Name() string
+	Select(repo repository.Repository, teamName string, candidates []models.User, n int) ([]models.User, error)
+}
+
+var strategies = map[string]ReviewerStrategy{
+	StrategyRandom:      randomStrategy{},
+	StrategyLeastLoaded: leastLoadedStrategy{},
+	StrategyRoundRobin:  roundRobinStrategy{},
+	StrategyWeighted:    weightedStrategy{},
+}
+
+func StrategyByName(name string) (ReviewerStrategy, error) {
+	strategy, ok := strategies[name]
+	if !ok {
+		return nil, errs.Newf(errs.ErrValidation, "unknown reviewer strategy %q", name)
+	}
+	return strategy, nil
+}
+
+type randomStrategy struct{}
+
+func (randomStrategy) Name() string { return StrategyRandom }
+
+func (randomStrategy) Select(_ repository.Repository, _ string, candidates []models.User, n int) ([]models.User, error) {
+	shuffled := shuffleUsers(candidates)
+	return shuffled[:min(n, len(shuffled))], nil
+}
+
+type leastLoadedStrategy struct{}
+
+func (leastLoadedStrategy) Name() string { return StrategyLeastLoaded }
+
+func (leastLoadedStrategy) Select(repo repository.Repository, _ string, candidates []models.User, n int) ([]models.User, error) {
+	ids := make([]string, len(candidates))
+	for i, c := range candidates {
+		ids[i] = c.UserId
+	}
+	load, err := repo.CountOpenReviews(ids)
+	if err != nil {
+		return nil, err
+	}
+	// Перемешиваем до сортировки, чтобы при равной нагрузке не выбирать всегда одних и тех же
+	sorted := shuffleUsers(candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return load[sorted[i].UserId] < load[sorted[j].UserId]
+	})
+	return sorted[:min(n, len(sorted))], nil
+}
+
+type roundRobinStrategy struct{}
+
+func (roundRobinStrategy) Name() string { return StrategyRoundRobin }
+
+func (roundRobinStrategy) Select(repo repository.Repository, teamName string, candidates []models.User, n int) ([]models.User, error) {
+	if len(candidates) == 0 || n <= 0 {
+		return nil, nil
+	}
+	cursor, err := repo.GetRoundRobinCursor(teamName)
+	if err != nil {
+		return nil, err
+	}
+	sorted := append([]models.User(nil), candidates...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].UserId < sorted[j].UserId })
+
+	// Начинаем с первого кандидата после последнего назначенного в команде
+	start := sort.Search(len(sorted), func(i int) bool { return sorted[i].UserId > cursor })
+	picked := make([]models.User, 0, min(n, len(sorted)))
+	for i := 0; i < len(sorted) && len(picked) < n; i++ {
+		picked = append(picked, sorted[(start+i)%len(sorted)])
+	}
+	if err := repo.SetRoundRobinCursor(teamName, picked[len(picked)-1].UserId); err != nil {
+		return nil, err
+	}
+	return picked, nil
+}
+
+type weightedStrategy struct{}
+
+func (weightedStrategy) Name() string { return StrategyWeighted }
+
+// Select делает взвешенную выборку без возвращения; пользователи с весом 0 не назначаются
+func (weightedStrategy) Select(_ repository.Repository, _ string, candidates []models.User, n int) ([]models.User, error) {
+	var pool []models.User
+	total := 0
+	for _, c := range candidates {
+		if c.ReviewWeight > 0 {
+			pool = append(pool, c)
+			total += c.ReviewWeight
+		}
+	}
+	var picked []models.User
+	for len(picked) < n && len(pool) > 0 {
+		r := rand.Intn(total)
+		for i, c := range pool {
+			if r < c.ReviewWeight {
+				picked = append(picked, c)
+				total -= c.ReviewWeight
+				pool = append(pool[:i], pool[i+1:]...)
+				break
+			}
+			r -= c.ReviewWeight
+		}
+	}
+	return picked, nil
+}
+
+func shuffleUsers(users []models.User) []models.User {
+	shuffled := append([]models.User(nil), users...)
+	rand.Shuffle(len(shuffled), func(i, j int) {
+		shuffled[i], shuffled[j] = shuffled[j], shuffled[i]
+	})
+	return shuffled
+}
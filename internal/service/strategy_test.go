@@ -0,0 +1,78 @@
+package service
+
+import (
+	"avito-internship/internal/models"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func strategyTeam() map[string][]models.TeamMember {
+	return map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+			{UserId: "rev3", Username: "rev3", IsActive: true},
+		},
+	}
+}
+
+func TestStrategyByName(t *testing.T) {
+	for _, name := range []string{StrategyRandom, StrategyLeastLoaded, StrategyRoundRobin, StrategyWeighted} {
+		strategy, err := StrategyByName(name)
+		require.NoError(t, err)
+		assert.Equal(t, name, strategy.Name())
+	}
+	_, err := StrategyByName("unknown")
+	assert.Error(t, err)
+}
+
+func TestService_CreatePR_RoundRobin(t *testing.T) {
+	svc, _ := newTestService(t, strategyTeam())
+	_, err := svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.ReviewerStrategy = StrategyRoundRobin })
+	require.NoError(t, err)
+
+	pr, err := svc.CreatePR("pr1", "PR 1", "author1", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev1", "rev2"}, pr.AssignedReviewers)
+
+	pr, err = svc.CreatePR("pr2", "PR 2", "author1", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev3", "rev1"}, pr.AssignedReviewers)
+}
+
+func TestService_CreatePR_LeastLoaded(t *testing.T) {
+	_, repo := newTestService(t, strategyTeam())
+	addOpenPR(t, repo, "busy1", "author1", "rev1", "rev2")
+	addOpenPR(t, repo, "busy2", "author1", "rev1")
+
+	pr, err := NewService(repo, WithDefaultStrategy(leastLoadedStrategy{})).CreatePR("pr1", "PR 1", "author1", nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"rev3", "rev2"}, pr.AssignedReviewers)
+}
+
+func TestService_CreatePR_Weighted(t *testing.T) {
+	svc, _ := newTestService(t, strategyTeam())
+	_, err := svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.ReviewerStrategy = StrategyWeighted })
+	require.NoError(t, err)
+	_, err = svc.SetUserReviewWeight("rev2", 0)
+	require.NoError(t, err)
+
+	pr, err := svc.CreatePR("pr1", "PR 1", "author1", nil, nil)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"rev1", "rev3"}, pr.AssignedReviewers)
+
+	_, err = svc.SetUserReviewWeight("rev2", -1)
+	assert.Error(t, err)
+}
+
+func TestService_SetTeamSettings_UnknownStrategy(t *testing.T) {
+	svc, _ := newTestService(t, strategyTeam())
+
+	_, err := svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.ReviewerStrategy = "unknown" })
+	assert.Error(t, err)
+	_, err = svc.SetTeamSettings("unknown", func(*models.TeamSettings) {})
+	assert.EqualError(t, err, "team not found")
+}
@@ -0,0 +1,290 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"avito-internship/internal/webhook"
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// WebhookSender выполняет одну попытку доставки события подписчику
+type WebhookSender interface {
+	Send(ctx context.Context, req webhook.Request) (int, error)
+}
+
+func WithWebhookSender(sender WebhookSender) Option {
+	return func(s *Service) {
+		s.webhooks = sender
+	}
+}
+
+const (
+	// deliveryBatch - сколько доставок обрабатывается за один запуск ProcessWebhooks
+	deliveryBatch        = 100
+	defaultDeliveryLimit = 100
+	maxDeliveryLimit     = 500
+)
+
+var webhookEvents = []string{
+	models.EventPRCreated,
+	models.EventPRMerged,
+	models.EventPRReviewerReassigned,
+	models.EventUserDeactivated,
+	models.EventUserSettingsUpdated,
+	models.EventTeamDeactivated,
+}
+
+func (s *Service) CreateWebhook(rawURL string, events []string, secret string) (models.WebhookSubscription, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return models.WebhookSubscription{}, errs.Newf(errs.ErrValidation, "invalid webhook url %q", rawURL)
+	}
+	if len(events) == 0 {
+		return models.WebhookSubscription{}, errs.New(errs.ErrValidation, "at least one event is required")
+	}
+	for i, event := range events {
+		if !contains(webhookEvents, event) || contains(events[:i], event) {
+			return models.WebhookSubscription{}, errs.Newf(errs.ErrValidation, "unknown or repeated event %q", event)
+		}
+	}
+	if secret == "" {
+		return models.WebhookSubscription{}, errs.New(errs.ErrValidation, "secret is required to sign payloads")
+	}
+	var webhook models.WebhookSubscription
+	err = s.repo.WithTx(func(repo repository.Repository) error {
+		var err error
+		webhook, err = repo.CreateWebhook(models.WebhookSubscription{URL: rawURL, Events: events, Secret: secret, CreatedAt: s.now()})
+		if err != nil {
+			return err
+		}
+		return s.audit(repo, webhookAudit(webhook.Id, models.AuditWebhookCreated), nil, webhookState(webhook))
+	})
+	if err != nil {
+		return models.WebhookSubscription{}, err
+	}
+	return webhook, nil
+}
+
+func (s *Service) GetWebhooks() ([]models.WebhookSubscription, error) {
+	return s.repo.GetWebhooks()
+}
+
+func (s *Service) DeleteWebhook(id int64) error {
+	return s.repo.WithTx(func(repo repository.Repository) error {
+		webhook, err := repo.GetWebhook(id)
+		if err != nil {
+			return err
+		}
+		if err := repo.DeleteWebhook(id); err != nil {
+			return err
+		}
+		return s.audit(repo, webhookAudit(id, models.AuditWebhookDeleted), webhookState(webhook), nil)
+	})
+}
+
+func webhookAudit(id int64, action string) models.AuditEntry {
+	return models.AuditEntry{Action: action, TargetType: models.AuditTargetWebhook, TargetId: strconv.FormatInt(id, 10)}
+}
+
+func (s *Service) GetDeliveries(filter models.DeliveryFilter) ([]models.WebhookDelivery, error) {
+	switch filter.Status {
+	case "", models.DeliveryPending, models.DeliveryDelivered, models.DeliveryFailed:
+	default:
+		return nil, errs.Newf(errs.ErrValidation, "unknown delivery status %q", filter.Status)
+	}
+	switch {
+	case filter.Limit == 0:
+		filter.Limit = defaultDeliveryLimit
+	case filter.Limit < 0 || filter.Limit > maxDeliveryLimit:
+		return nil, errs.Newf(errs.ErrValidation, "limit must be between 1 and %d", maxDeliveryLimit)
+	}
+	if filter.WebhookId != 0 {
+		if _, err := s.repo.GetWebhook(filter.WebhookId); err != nil {
+			return nil, err
+		}
+	}
+	return s.repo.GetDeliveries(filter)
+}
+
+// ReplayDelivery ставит доставку в очередь заново с обнулённым счётчиком попыток; EventId не меняется
+func (s *Service) ReplayDelivery(id int64) (models.WebhookDelivery, error) {
+	var delivery models.WebhookDelivery
+	err := s.repo.WithTx(func(repo repository.Repository) error {
+		var err error
+		if delivery, err = repo.GetDelivery(id); err != nil {
+			return err
+		}
+		before := deliverySnapshot{Id: delivery.Id, EventId: delivery.EventId, Status: delivery.Status, Attempts: delivery.Attempts}
+		delivery.Status = models.DeliveryPending
+		delivery.Attempts = 0
+		delivery.NextAttemptAt = s.now()
+		delivery.LastError = ""
+		delivery.ResponseStatus = 0
+		delivery.DeliveredAt = nil
+		if err := repo.UpdateDelivery(delivery); err != nil {
+			return err
+		}
+		after := deliverySnapshot{Id: delivery.Id, EventId: delivery.EventId, Status: delivery.Status}
+		return s.audit(repo, webhookAudit(delivery.WebhookId, models.AuditDeliveryReplayed), before, after)
+	})
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	return delivery, nil
+}
+
+// ProcessWebhooks выполняет попытки доставки, время которых наступило. После неудачи следующая попытка
+// откладывается по экспоненте, после webhook.MaxAttempts неудач доставка помечается FAILED.
+func (s *Service) ProcessWebhooks(ctx context.Context) (delivered int, err error) {
+	due, err := s.repo.GetDueDeliveries(s.now(), deliveryBatch)
+	if err != nil {
+		return 0, err
+	}
+	for _, d := range due {
+		if ctx.Err() != nil {
+			return delivered, ctx.Err()
+		}
+		sub, err := s.repo.GetWebhook(d.WebhookId)
+		if err != nil {
+			return delivered, err
+		}
+		status, sendErr := s.webhooks.Send(ctx, webhook.Request{
+			URL:        sub.URL,
+			Secret:     sub.Secret,
+			Event:      d.Event,
+			EventId:    d.EventId,
+			DeliveryId: d.Id,
+			Payload:    d.Payload,
+		})
+		now := s.now()
+		d.Attempts++
+		d.ResponseStatus = status
+		if sendErr == nil {
+			d.Status = models.DeliveryDelivered
+			d.LastError = ""
+			d.DeliveredAt = &now
+			delivered++
+		} else {
+			d.LastError = sendErr.Error()
+			if d.Attempts >= webhook.MaxAttempts {
+				d.Status = models.DeliveryFailed
+			} else {
+				d.NextAttemptAt = now.Add(webhook.Backoff(d.Attempts))
+			}
+		}
+		if err := s.repo.UpdateDelivery(d); err != nil {
+			return delivered, err
+		}
+	}
+	return delivered, nil
+}
+
+type webhookEvent struct {
+	Id         string    `json:"id"`
+	Event      string    `json:"event"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+type pullRequestEventData struct {
+	PullRequestId     string     `json:"pull_request_id"`
+	PullRequestName   string     `json:"pull_request_name"`
+	AuthorId          string     `json:"author_id"`
+	Status            string     `json:"status"`
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	CreatedAt         *time.Time `json:"created_at,omitempty"`
+	MergedAt          *time.Time `json:"merged_at,omitempty"`
+	ForceMerged       bool       `json:"force_merged"`
+}
+
+func pullRequestEvent(pr models.PullRequest) pullRequestEventData {
+	return pullRequestEventData{
+		PullRequestId:     pr.PullRequestId,
+		PullRequestName:   pr.PullRequestName,
+		AuthorId:          pr.AuthorId,
+		Status:            pr.Status,
+		AssignedReviewers: append([]string{}, pr.AssignedReviewers...),
+		CreatedAt:         pr.CreatedAt,
+		MergedAt:          pr.MergedAt,
+		ForceMerged:       pr.ForceMerged,
+	}
+}
+
+type reassignEventData struct {
+	PullRequest   pullRequestEventData `json:"pull_request"`
+	OldReviewerId string               `json:"old_reviewer_id"`
+	NewReviewerId string               `json:"new_reviewer_id"`
+}
+
+type userEventData struct {
+	UserId   string `json:"user_id"`
+	TeamName string `json:"team_name"`
+}
+
+type userSettingsEventData struct {
+	UserId         string `json:"user_id"`
+	TeamName       string `json:"team_name"`
+	ReviewWeight   int    `json:"review_weight"`
+	IsLead         bool   `json:"is_lead"`
+	MaxOpenReviews int    `json:"max_open_reviews"`
+}
+
+type teamEventData struct {
+	TeamName   string   `json:"team_name"`
+	UserIds    []string `json:"user_ids"`
+	Reassigned int      `json:"reassigned"`
+}
+
+// emit записывает событие в outbox и ставит в журнал доставок каждой подписки на него. Вызывается
+// в той же транзакции, что и изменение, поэтому откатанное изменение не порождает событий.
+func (s *Service) emit(repo repository.Repository, event string, data any) error {
+	eventId, err := newEventId()
+	if err != nil {
+		return err
+	}
+	envelope := webhookEvent{Id: eventId, Event: event, OccurredAt: s.now().UTC(), Data: data}
+	payload, err := json.Marshal(envelope)
+	if err != nil {
+		return err
+	}
+	if err := repo.AddOutboxEvent(models.OutboxEvent{EventId: eventId, Event: event, Payload: payload, OccurredAt: envelope.OccurredAt}); err != nil {
+		return err
+	}
+	subscriptions, err := repo.GetWebhooks()
+	if err != nil {
+		return err
+	}
+	for _, sub := range subscriptions {
+		if !contains(sub.Events, event) {
+			continue
+		}
+		_, err := repo.AddDelivery(models.WebhookDelivery{
+			WebhookId:     sub.Id,
+			EventId:       eventId,
+			Event:         event,
+			Payload:       payload,
+			Status:        models.DeliveryPending,
+			NextAttemptAt: s.now(),
+			CreatedAt:     s.now(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newEventId() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/webhook"
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeSender struct {
+	requests []webhook.Request
+	err      error
+}
+
+func (f *fakeSender) Send(_ context.Context, req webhook.Request) (int, error) {
+	f.requests = append(f.requests, req)
+	if f.err != nil {
+		return 503, f.err
+	}
+	return 200, nil
+}
+
+func TestService_Webhooks(t *testing.T) {
+	now := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {
+			{UserId: "author1", Username: "author", IsActive: true},
+			{UserId: "rev1", Username: "rev1", IsActive: true},
+			{UserId: "rev2", Username: "rev2", IsActive: true},
+		},
+	})
+	sender := &fakeSender{}
+	svc := NewService(repo, WithClock(func() time.Time { return now }), WithWebhookSender(sender))
+
+	_, err := svc.CreateWebhook("ftp://bot", []string{models.EventPRCreated}, "s")
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.CreateWebhook("https://bot", []string{"pr.closed"}, "s")
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.CreateWebhook("https://bot", []string{models.EventPRCreated}, "")
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	bot, err := svc.CreateWebhook("https://bot", []string{models.EventPRCreated, models.EventUserDeactivated}, "s3cret")
+	require.NoError(t, err)
+	dashboard, err := svc.CreateWebhook("https://dashboard", []string{models.EventPRMerged}, "other")
+	require.NoError(t, err)
+
+	_, err = svc.CreatePR("pr1", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	_, _, err = svc.SetUserActive("rev1", false, false, false)
+	require.NoError(t, err)
+	_, err = svc.MergePR("pr1", true)
+	require.NoError(t, err)
+
+	deliveries, err := svc.GetDeliveries(models.DeliveryFilter{WebhookId: bot.Id})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, models.EventUserDeactivated, deliveries[0].Event)
+	assert.Equal(t, models.EventPRCreated, deliveries[1].Event)
+	var created struct {
+		Id    string
+		Event string
+		Data  struct {
+			PullRequestId     string   `json:"pull_request_id"`
+			AssignedReviewers []string `json:"assigned_reviewers"`
+		}
+	}
+	require.NoError(t, json.Unmarshal(deliveries[1].Payload, &created))
+	assert.Equal(t, deliveries[1].EventId, created.Id)
+	assert.Equal(t, "pr1", created.Data.PullRequestId)
+	assert.Len(t, created.Data.AssignedReviewers, 2)
+
+	// Отклонённое слияние не порождает события
+	_, err = svc.CreatePR("pr2", "PR", "author1", nil, nil)
+	require.NoError(t, err)
+	_, err = svc.SetTeamSettings("team1", func(settings *models.TeamSettings) { settings.MinApprovals = 1 })
+	require.NoError(t, err)
+	_, err = svc.MergePR("pr2", false)
+	require.ErrorIs(t, err, errs.ErrMergeBlocked)
+	deliveries, err = svc.GetDeliveries(models.DeliveryFilter{WebhookId: dashboard.Id})
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+
+	sender.err = errors.New("connection refused")
+	delivered, err := svc.ProcessWebhooks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 0, delivered)
+	require.Len(t, sender.requests, 4)
+	assert.Equal(t, "s3cret", sender.requests[0].Secret)
+	pending, err := svc.GetDeliveries(models.DeliveryFilter{Status: models.DeliveryPending})
+	require.NoError(t, err)
+	require.Len(t, pending, 4)
+	for _, d := range pending {
+		assert.Equal(t, 1, d.Attempts)
+		assert.Equal(t, 503, d.ResponseStatus)
+		assert.Equal(t, now.Add(webhook.Backoff(1)), d.NextAttemptAt)
+	}
+
+	// Пока пауза не прошла, повторных попыток нет
+	_, err = svc.ProcessWebhooks(context.Background())
+	require.NoError(t, err)
+	assert.Len(t, sender.requests, 4)
+
+	sender.err = nil
+	now = now.Add(webhook.Backoff(1))
+	delivered, err = svc.ProcessWebhooks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 4, delivered)
+
+	replayed, err := svc.ReplayDelivery(pending[0].Id)
+	require.NoError(t, err)
+	assert.Equal(t, models.DeliveryPending, replayed.Status)
+	assert.Equal(t, 0, replayed.Attempts)
+	delivered, err = svc.ProcessWebhooks(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, 1, delivered)
+	assert.Equal(t, pending[0].EventId, sender.requests[len(sender.requests)-1].EventId)
+
+	_, err = svc.GetDeliveries(models.DeliveryFilter{Status: "LOST"})
+	assert.ErrorIs(t, err, errs.ErrValidation)
+	_, err = svc.ReplayDelivery(100)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	require.NoError(t, svc.DeleteWebhook(bot.Id))
+	_, err = svc.GetDeliveries(models.DeliveryFilter{WebhookId: bot.Id})
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+}
+
+func TestService_ProcessWebhooks_GivesUp(t *testing.T) {
+	now := time.Date(2025, 10, 20, 9, 0, 0, 0, time.UTC)
+	repo := newFakeRepo(t, map[string][]models.TeamMember{
+		"team1": {{UserId: "u1", Username: "u1", IsActive: true}},
+	})
+	sender := &fakeSender{err: errors.New("timeout")}
+	svc := NewService(repo, WithClock(func() time.Time { return now }), WithWebhookSender(sender))
+	_, err := svc.CreateWebhook("https://bot", []string{models.EventTeamDeactivated}, "s3cret")
+	require.NoError(t, err)
+	_, err = svc.DeactivateTeam("team1", false)
+	require.NoError(t, err)
+
+	for i := 1; i <= webhook.MaxAttempts; i++ {
+		_, err := svc.ProcessWebhooks(context.Background())
+		require.NoError(t, err)
+		now = now.Add(webhook.Backoff(i))
+	}
+	failed, err := svc.GetDeliveries(models.DeliveryFilter{Status: models.DeliveryFailed})
+	require.NoError(t, err)
+	require.Len(t, failed, 1)
+	assert.Equal(t, webhook.MaxAttempts, failed[0].Attempts)
+	assert.Equal(t, "timeout", failed[0].LastError)
+	assert.Len(t, sender.requests, webhook.MaxAttempts)
+}
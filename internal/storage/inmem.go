@@ -0,0 +1,1075 @@
+package storage
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+)
+
+var _ repository.Repository = (*InMemStorage)(nil)
+
+type InMemStorage struct {
+	*inMemData
+	mu *sync.RWMutex
+	// tx означает, что блокировка уже захвачена в WithTx
+	tx bool
+	// undo - отмена изменений текущей транзакции в порядке их внесения
+	undo *[]func()
+}
+
+type inMemData struct {
+	teams     map[string]models.TeamSettings
+	rrCursors map[string]string
+	users     map[string]models.User
+	userIds   []string
+	prs       map[string]models.PullRequest
+	prIds     []string
+	// Назначения по PR в порядке назначения, включая снятые
+	assignments map[string][]models.Assignment
+	reviews     map[string][]models.Review
+	prEvents    map[string][]models.PullRequestEvent
+	codeOwners  []models.CodeOwnerRule
+	absences    []models.Absence
+	absenceSeq  int64
+	hours       map[string]models.WorkingHours
+	webhooks    []models.WebhookSubscription
+	webhookSeq  int64
+	// Журнал доставок в порядке добавления
+	deliveries  []models.WebhookDelivery
+	deliverySeq int64
+	// Ключ - forge и имя пользователя через "/"
+	forgeIdentities map[string]models.ForgeIdentity
+	// Журнал аудита в порядке добавления
+	audit    []models.AuditEntry
+	auditSeq int64
+	// Outbox в порядке записи и отметки о передаче событий по имени sink
+	outbox          []models.OutboxEvent
+	outboxSeq       int64
+	outboxPublished map[string]map[int64]bool
+}
+
+func NewInMemStorage() *InMemStorage {
+	return &InMemStorage{
+		inMemData: &inMemData{
+			teams:           make(map[string]models.TeamSettings),
+			rrCursors:       make(map[string]string),
+			users:           make(map[string]models.User),
+			prs:             make(map[string]models.PullRequest),
+			assignments:     make(map[string][]models.Assignment),
+			reviews:         make(map[string][]models.Review),
+			prEvents:        make(map[string][]models.PullRequestEvent),
+			hours:           make(map[string]models.WorkingHours),
+			forgeIdentities: make(map[string]models.ForgeIdentity),
+			outboxPublished: make(map[string]map[int64]bool),
+		},
+		mu: &sync.RWMutex{},
+	}
+}
+
+// WithTx сериализует транзакции общей блокировкой и при ошибке отменяет изменения транзакции в обратном порядке.
+// Каждый метод записи запоминает только то, что меняет, поэтому транзакция не копирует остальные данные.
+func (s *InMemStorage) WithTx(fn func(repo repository.Repository) error) error {
+	if s.tx {
+		return fn(s)
+	}
+	defer s.lock()()
+
+	var undo []func()
+	if err := fn(&InMemStorage{inMemData: s.inMemData, mu: s.mu, tx: true, undo: &undo}); err != nil {
+		for i := len(undo) - 1; i >= 0; i-- {
+			undo[i]()
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *InMemStorage) lock() func() {
+	if s.tx {
+		return func() {}
+	}
+	s.mu.Lock()
+	return s.mu.Unlock
+}
+
+func (s *InMemStorage) rlock() func() {
+	if s.tx {
+		return func() {}
+	}
+	s.mu.RLock()
+	return s.mu.RUnlock
+}
+
+// save запоминает значение до изменения, чтобы восстановить его при откате транзакции.
+// Срезы восстанавливаются по старому заголовку, поэтому элементы, изменяемые на месте, сохраняются отдельно.
+func save[T any](s *InMemStorage, p *T) {
+	if s.undo == nil {
+		return
+	}
+	old := *p
+	*s.undo = append(*s.undo, func() { *p = old })
+}
+
+// saveKey запоминает значение ключа map до изменения; отсутствующий ключ при откате удаляется
+func saveKey[K comparable, V any](s *InMemStorage, m map[K]V, k K) {
+	if s.undo == nil {
+		return
+	}
+	old, ok := m[k]
+	*s.undo = append(*s.undo, func() {
+		if ok {
+			m[k] = old
+		} else {
+			delete(m, k)
+		}
+	})
+}
+
+func (s *InMemStorage) AddTeam(teamName string, members []models.TeamMember) error {
+	defer s.lock()()
+
+	if _, ok := s.teams[teamName]; ok {
+		return errs.New(errs.ErrTeamExists, "team already exists")
+	}
+	// Проверяем всех участников до записи, чтобы не оставить команду частично созданной
+	seen := make(map[string]struct{}, len(members))
+	for _, m := range members {
+		if _, ok := s.users[m.UserId]; ok {
+			return errs.New(errs.ErrAlreadyExists, "user already exists")
+		}
+		if _, ok := seen[m.UserId]; ok {
+			return errs.New(errs.ErrAlreadyExists, "user already exists")
+		}
+		seen[m.UserId] = struct{}{}
+	}
+
+	saveKey(s, s.teams, teamName)
+	s.teams[teamName] = models.TeamSettings{TeamName: teamName, MaxReviewers: models.DefaultMaxReviewers}
+	save(s, &s.userIds)
+	for _, m := range members {
+		saveKey(s, s.users, m.UserId)
+		s.users[m.UserId] = models.User{
+			UserId:       m.UserId,
+			Username:     m.Username,
+			TeamName:     teamName,
+			IsActive:     m.IsActive,
+			ReviewWeight: 1,
+		}
+		s.userIds = append(s.userIds, m.UserId)
+	}
+	return nil
+}
+
+func (s *InMemStorage) GetTeam(teamName string) ([]models.TeamMember, error) {
+	defer s.rlock()()
+
+	var members []models.TeamMember
+	for _, id := range s.userIds {
+		u := s.users[id]
+		if u.TeamName == teamName {
+			members = append(members, models.TeamMember{
+				UserId:   u.UserId,
+				Username: u.Username,
+				IsActive: u.IsActive,
+			})
+		}
+	}
+	if len(members) == 0 {
+		return nil, errs.New(errs.ErrNotFound, "team not found")
+	}
+	return members, nil
+}
+
+func (s *InMemStorage) SetUserActive(userId string, isActive bool) error {
+	defer s.lock()()
+
+	u, ok := s.users[userId]
+	if !ok {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	u.IsActive = isActive
+	saveKey(s, s.users, userId)
+	s.users[userId] = u
+	return nil
+}
+
+func (s *InMemStorage) SetUserLead(userId string, isLead bool) error {
+	defer s.lock()()
+
+	u, ok := s.users[userId]
+	if !ok {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	u.IsLead = isLead
+	saveKey(s, s.users, userId)
+	s.users[userId] = u
+	return nil
+}
+
+func (s *InMemStorage) SetUserReviewWeight(userId string, weight int) error {
+	defer s.lock()()
+
+	u, ok := s.users[userId]
+	if !ok {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	u.ReviewWeight = weight
+	saveKey(s, s.users, userId)
+	s.users[userId] = u
+	return nil
+}
+
+func (s *InMemStorage) SetUserMaxOpenReviews(userId string, limit int) error {
+	defer s.lock()()
+
+	u, ok := s.users[userId]
+	if !ok {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	u.MaxOpenReviews = limit
+	saveKey(s, s.users, userId)
+	s.users[userId] = u
+	return nil
+}
+
+func (s *InMemStorage) CreatePR(pr models.PullRequest) error {
+	defer s.lock()()
+
+	if _, ok := s.prs[pr.PullRequestId]; ok {
+		return errs.New(errs.ErrPRExists, "PR already exists")
+	}
+	if _, ok := s.users[pr.AuthorId]; !ok {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	for _, reviewer := range pr.AssignedReviewers {
+		if _, ok := s.users[reviewer]; !ok {
+			return errs.New(errs.ErrNotFound, "user not found")
+		}
+	}
+	var assignedAt time.Time
+	if pr.CreatedAt != nil {
+		assignedAt = *pr.CreatedAt
+	}
+	saveKey(s, s.assignments, pr.PullRequestId)
+	for _, reviewer := range pr.AssignedReviewers {
+		s.assignments[pr.PullRequestId] = append(s.assignments[pr.PullRequestId], models.Assignment{
+			PullRequestId: pr.PullRequestId,
+			UserId:        reviewer,
+			Role:          models.RoleReviewer,
+			AssignedAt:    assignedAt,
+		})
+	}
+	pr.AssignedReviewers = nil
+	saveKey(s, s.prs, pr.PullRequestId)
+	s.prs[pr.PullRequestId] = pr
+	save(s, &s.prIds)
+	s.prIds = append(s.prIds, pr.PullRequestId)
+	return nil
+}
+
+func (s *InMemStorage) GetPR(prId string) (models.PullRequest, error) {
+	defer s.rlock()()
+
+	pr, ok := s.prs[prId]
+	if !ok {
+		return models.PullRequest{}, errs.New(errs.ErrNotFound, "PR not found")
+	}
+	pr.AssignedReviewers = s.activeReviewers(prId)
+	return pr, nil
+}
+
+func (s *InMemStorage) UpdatePR(pr models.PullRequest) error {
+	defer s.lock()()
+
+	old, ok := s.prs[pr.PullRequestId]
+	if !ok || old.Version != pr.Version {
+		return repository.ErrConflict
+	}
+	// created_at не обновляется, а ревьюверы меняются только через назначения, как и в Postgres
+	pr.CreatedAt = old.CreatedAt
+	pr.AssignedReviewers = nil
+	pr.Version++
+	saveKey(s, s.prs, pr.PullRequestId)
+	s.prs[pr.PullRequestId] = pr
+	return nil
+}
+
+func (s *InMemStorage) AssignReviewer(assignment models.Assignment) error {
+	defer s.lock()()
+
+	if _, ok := s.prs[assignment.PullRequestId]; !ok {
+		return errs.New(errs.ErrNotFound, "PR not found")
+	}
+	if _, ok := s.users[assignment.UserId]; !ok {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	for _, a := range s.assignments[assignment.PullRequestId] {
+		if a.UserId == assignment.UserId && a.UnassignedAt == nil {
+			return errs.New(errs.ErrAlreadyExists, "reviewer is already assigned to this PR")
+		}
+	}
+	assignment.UnassignedAt = nil
+	saveKey(s, s.assignments, assignment.PullRequestId)
+	s.assignments[assignment.PullRequestId] = append(s.assignments[assignment.PullRequestId], assignment)
+	return nil
+}
+
+func (s *InMemStorage) UnassignReviewer(prId, userId string, at time.Time) error {
+	defer s.lock()()
+
+	for i, a := range s.assignments[prId] {
+		if a.UserId == userId && a.UnassignedAt == nil {
+			save(s, &s.assignments[prId][i])
+			s.assignments[prId][i].UnassignedAt = &at
+			return nil
+		}
+	}
+	return errs.New(errs.ErrNotAssigned, "reviewer is not assigned to this PR")
+}
+
+func (s *InMemStorage) GetAssignments(prId string) ([]models.Assignment, error) {
+	defer s.rlock()()
+
+	var assignments []models.Assignment
+	for _, a := range s.assignments[prId] {
+		if a.UnassignedAt != nil {
+			unassignedAt := *a.UnassignedAt
+			a.UnassignedAt = &unassignedAt
+		}
+		if a.OverdueAt != nil {
+			overdueAt := *a.OverdueAt
+			a.OverdueAt = &overdueAt
+		}
+		assignments = append(assignments, a)
+	}
+	return assignments, nil
+}
+
+func (s *InMemStorage) GetUnansweredAssignments() ([]models.Assignment, error) {
+	defer s.rlock()()
+
+	var assignments []models.Assignment
+	for _, prId := range s.prIds {
+		if s.prs[prId].Status != "OPEN" {
+			continue
+		}
+		for _, a := range s.assignments[prId] {
+			if a.UnassignedAt != nil || a.Role != models.RoleReviewer {
+				continue
+			}
+			answered := false
+			for _, r := range s.reviews[prId] {
+				answered = answered || (r.ReviewerId == a.UserId && !r.SubmittedAt.Before(a.AssignedAt))
+			}
+			if answered {
+				continue
+			}
+			if a.OverdueAt != nil {
+				overdueAt := *a.OverdueAt
+				a.OverdueAt = &overdueAt
+			}
+			if a.ReassignFailedAt != nil {
+				failedAt := *a.ReassignFailedAt
+				a.ReassignFailedAt = &failedAt
+			}
+			assignments = append(assignments, a)
+		}
+	}
+	sort.SliceStable(assignments, func(i, j int) bool {
+		return assignments[i].AssignedAt.Before(assignments[j].AssignedAt)
+	})
+	return assignments, nil
+}
+
+func (s *InMemStorage) MarkAssignmentOverdue(prId, userId string, at time.Time) error {
+	return s.markAssignment(prId, userId, func(a *models.Assignment) { a.OverdueAt = &at })
+}
+
+func (s *InMemStorage) MarkReassignFailed(prId, userId string, at time.Time) error {
+	return s.markAssignment(prId, userId, func(a *models.Assignment) { a.ReassignFailedAt = &at })
+}
+
+// markAssignment применяет mark к текущему назначению ревьювера
+func (s *InMemStorage) markAssignment(prId, userId string, mark func(a *models.Assignment)) error {
+	defer s.lock()()
+
+	for i, a := range s.assignments[prId] {
+		if a.UserId == userId && a.UnassignedAt == nil {
+			save(s, &s.assignments[prId][i])
+			mark(&s.assignments[prId][i])
+			return nil
+		}
+	}
+	return errs.New(errs.ErrNotAssigned, "reviewer is not assigned to this PR")
+}
+
+func (s *InMemStorage) AddReview(review models.Review) error {
+	defer s.lock()()
+
+	if _, ok := s.prs[review.PullRequestId]; !ok {
+		return errs.New(errs.ErrNotFound, "PR not found")
+	}
+	if _, ok := s.users[review.ReviewerId]; !ok {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	saveKey(s, s.reviews, review.PullRequestId)
+	s.reviews[review.PullRequestId] = append(s.reviews[review.PullRequestId], review)
+	return nil
+}
+
+func (s *InMemStorage) GetReviews(prId string) ([]models.Review, error) {
+	defer s.rlock()()
+
+	return append([]models.Review(nil), s.reviews[prId]...), nil
+}
+
+func (s *InMemStorage) AddPREvent(event models.PullRequestEvent) error {
+	defer s.lock()()
+
+	if _, ok := s.prs[event.PullRequestId]; !ok {
+		return errs.New(errs.ErrNotFound, "PR not found")
+	}
+	saveKey(s, s.prEvents, event.PullRequestId)
+	s.prEvents[event.PullRequestId] = append(s.prEvents[event.PullRequestId], event)
+	return nil
+}
+
+func (s *InMemStorage) GetPREvents(prId string) ([]models.PullRequestEvent, error) {
+	defer s.rlock()()
+
+	return append([]models.PullRequestEvent(nil), s.prEvents[prId]...), nil
+}
+
+func (s *InMemStorage) GetUser(userId string) (models.User, error) {
+	defer s.rlock()()
+
+	u, ok := s.users[userId]
+	if !ok {
+		return models.User{}, errs.New(errs.ErrNotFound, "user not found")
+	}
+	return u, nil
+}
+
+func (s *InMemStorage) GetUsersByTeam(teamName string) ([]models.User, error) {
+	defer s.rlock()()
+
+	var users []models.User
+	for _, id := range s.userIds {
+		if u := s.users[id]; u.TeamName == teamName {
+			users = append(users, u)
+		}
+	}
+	return users, nil
+}
+
+func (s *InMemStorage) GetPRsByReviewer(userId string) ([]models.PullRequestShort, error) {
+	defer s.rlock()()
+
+	type match struct {
+		pr         models.PullRequestShort
+		assignedAt time.Time
+	}
+	var matches []match
+	for _, id := range s.prIds {
+		for _, a := range s.assignments[id] {
+			if a.UserId == userId && a.UnassignedAt == nil {
+				pr := s.prs[id]
+				matches = append(matches, match{
+					pr: models.PullRequestShort{
+						PullRequestId:   pr.PullRequestId,
+						PullRequestName: pr.PullRequestName,
+						AuthorId:        pr.AuthorId,
+						Status:          pr.Status,
+					},
+					assignedAt: a.AssignedAt,
+				})
+				break
+			}
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].assignedAt.Before(matches[j].assignedAt)
+	})
+	var prs []models.PullRequestShort
+	for _, m := range matches {
+		prs = append(prs, m.pr)
+	}
+	return prs, nil
+}
+
+func (s *InMemStorage) CountOpenReviews(userIds []string) (map[string]int, error) {
+	defer s.rlock()()
+
+	wanted := make(map[string]struct{}, len(userIds))
+	for _, id := range userIds {
+		wanted[id] = struct{}{}
+	}
+	counts := make(map[string]int, len(userIds))
+	for prId, pr := range s.prs {
+		if pr.Status != "OPEN" {
+			continue
+		}
+		for _, r := range s.activeReviewers(prId) {
+			if _, ok := wanted[r]; ok {
+				counts[r]++
+			}
+		}
+	}
+	return counts, nil
+}
+
+func (s *InMemStorage) GetAssignmentStats() ([]models.AssignmentStat, error) {
+	defer s.rlock()()
+
+	counts := make(map[string]int)
+	for prId := range s.prs {
+		for _, r := range s.activeReviewers(prId) {
+			counts[r]++
+		}
+	}
+	var stats []models.AssignmentStat
+	for userId, count := range counts {
+		stats = append(stats, models.AssignmentStat{UserId: userId, Count: count})
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		return stats[i].UserId < stats[j].UserId
+	})
+	return stats, nil
+}
+
+func (s *InMemStorage) CountOpenPRsByTeam() (map[string]int, error) {
+	defer s.rlock()()
+
+	counts := make(map[string]int, len(s.teams))
+	for teamName := range s.teams {
+		counts[teamName] = 0
+	}
+	for _, pr := range s.prs {
+		if pr.Status == "OPEN" {
+			counts[s.users[pr.AuthorId].TeamName]++
+		}
+	}
+	return counts, nil
+}
+
+func (s *InMemStorage) GetReviewerLoads() ([]models.ReviewerLoad, error) {
+	defer s.rlock()()
+
+	counts := make(map[string]int)
+	for prId, pr := range s.prs {
+		if pr.Status != "OPEN" {
+			continue
+		}
+		for _, r := range s.activeReviewers(prId) {
+			counts[r]++
+		}
+	}
+	var loads []models.ReviewerLoad
+	for _, id := range s.userIds {
+		u := s.users[id]
+		if u.IsActive {
+			limit := u.MaxOpenReviews
+			if limit == 0 {
+				limit = s.teams[u.TeamName].MaxOpenReviews
+			}
+			loads = append(loads, models.ReviewerLoad{UserId: id, TeamName: u.TeamName, OpenReviews: counts[id], MaxOpenReviews: limit})
+		}
+	}
+	sort.Slice(loads, func(i, j int) bool { return loads[i].UserId < loads[j].UserId })
+	return loads, nil
+}
+
+func (s *InMemStorage) DeactivateTeam(teamName string) error {
+	defer s.lock()()
+
+	rows := 0
+	for id, u := range s.users {
+		if u.TeamName == teamName {
+			u.IsActive = false
+			saveKey(s, s.users, id)
+			s.users[id] = u
+			rows++
+		}
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotFound, "team not found or no users to deactivate")
+	}
+	return nil
+}
+
+func (s *InMemStorage) CreateWebhook(webhook models.WebhookSubscription) (models.WebhookSubscription, error) {
+	defer s.lock()()
+
+	save(s, &s.webhookSeq)
+	s.webhookSeq++
+	webhook.Id = s.webhookSeq
+	webhook.Events = append([]string(nil), webhook.Events...)
+	save(s, &s.webhooks)
+	s.webhooks = append(s.webhooks, webhook)
+	return webhook, nil
+}
+
+func (s *InMemStorage) GetWebhook(id int64) (models.WebhookSubscription, error) {
+	defer s.rlock()()
+
+	for _, w := range s.webhooks {
+		if w.Id == id {
+			w.Events = append([]string(nil), w.Events...)
+			return w, nil
+		}
+	}
+	return models.WebhookSubscription{}, errs.New(errs.ErrNotFound, "webhook not found")
+}
+
+func (s *InMemStorage) GetWebhooks() ([]models.WebhookSubscription, error) {
+	defer s.rlock()()
+
+	webhooks := make([]models.WebhookSubscription, len(s.webhooks))
+	for i, w := range s.webhooks {
+		w.Events = append([]string(nil), w.Events...)
+		webhooks[i] = w
+	}
+	return webhooks, nil
+}
+
+func (s *InMemStorage) DeleteWebhook(id int64) error {
+	defer s.lock()()
+
+	for i, w := range s.webhooks {
+		if w.Id != id {
+			continue
+		}
+		save(s, &s.webhooks)
+		s.webhooks = append(s.webhooks[:i:i], s.webhooks[i+1:]...)
+		// Как ON DELETE CASCADE в Postgres
+		var deliveries []models.WebhookDelivery
+		for _, d := range s.deliveries {
+			if d.WebhookId != id {
+				deliveries = append(deliveries, d)
+			}
+		}
+		save(s, &s.deliveries)
+		s.deliveries = deliveries
+		return nil
+	}
+	return errs.New(errs.ErrNotFound, "webhook not found")
+}
+
+func (s *InMemStorage) AddDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	defer s.lock()()
+
+	found := false
+	for _, w := range s.webhooks {
+		found = found || w.Id == delivery.WebhookId
+	}
+	if !found {
+		return models.WebhookDelivery{}, errs.New(errs.ErrNotFound, "webhook not found")
+	}
+	save(s, &s.deliverySeq)
+	s.deliverySeq++
+	delivery.Id = s.deliverySeq
+	save(s, &s.deliveries)
+	s.deliveries = append(s.deliveries, delivery)
+	return delivery, nil
+}
+
+func (s *InMemStorage) GetDelivery(id int64) (models.WebhookDelivery, error) {
+	defer s.rlock()()
+
+	for _, d := range s.deliveries {
+		if d.Id == id {
+			return d, nil
+		}
+	}
+	return models.WebhookDelivery{}, errs.New(errs.ErrNotFound, "delivery not found")
+}
+
+func (s *InMemStorage) GetDeliveries(filter models.DeliveryFilter) ([]models.WebhookDelivery, error) {
+	defer s.rlock()()
+
+	var deliveries []models.WebhookDelivery
+	for i := len(s.deliveries) - 1; i >= 0; i-- {
+		d := s.deliveries[i]
+		if (filter.WebhookId != 0 && d.WebhookId != filter.WebhookId) || (filter.Status != "" && d.Status != filter.Status) {
+			continue
+		}
+		if filter.Limit > 0 && len(deliveries) >= filter.Limit {
+			break
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+func (s *InMemStorage) GetDueDeliveries(at time.Time, limit int) ([]models.WebhookDelivery, error) {
+	defer s.rlock()()
+
+	var deliveries []models.WebhookDelivery
+	for _, d := range s.deliveries {
+		if d.Status == models.DeliveryPending && !d.NextAttemptAt.After(at) {
+			deliveries = append(deliveries, d)
+		}
+	}
+	sort.SliceStable(deliveries, func(i, j int) bool {
+		return deliveries[i].NextAttemptAt.Before(deliveries[j].NextAttemptAt)
+	})
+	if len(deliveries) > limit {
+		deliveries = deliveries[:limit]
+	}
+	return deliveries, nil
+}
+
+func (s *InMemStorage) UpdateDelivery(delivery models.WebhookDelivery) error {
+	defer s.lock()()
+
+	for i, d := range s.deliveries {
+		if d.Id == delivery.Id {
+			save(s, &s.deliveries[i])
+			s.deliveries[i] = delivery
+			return nil
+		}
+	}
+	return errs.New(errs.ErrNotFound, "delivery not found")
+}
+
+func forgeKey(forge, username string) string {
+	return forge + "/" + username
+}
+
+func (s *InMemStorage) SetForgeIdentity(identity models.ForgeIdentity) error {
+	defer s.lock()()
+
+	if _, ok := s.users[identity.UserId]; !ok {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	key := forgeKey(identity.Forge, identity.Username)
+	saveKey(s, s.forgeIdentities, key)
+	s.forgeIdentities[key] = identity
+	return nil
+}
+
+func (s *InMemStorage) DeleteForgeIdentity(forge, username string) error {
+	defer s.lock()()
+
+	key := forgeKey(forge, username)
+	if _, ok := s.forgeIdentities[key]; !ok {
+		return errs.New(errs.ErrNotFound, "forge identity not found")
+	}
+	saveKey(s, s.forgeIdentities, key)
+	delete(s.forgeIdentities, key)
+	return nil
+}
+
+func (s *InMemStorage) GetForgeIdentities() ([]models.ForgeIdentity, error) {
+	defer s.rlock()()
+
+	identities := make([]models.ForgeIdentity, 0, len(s.forgeIdentities))
+	for _, i := range s.forgeIdentities {
+		identities = append(identities, i)
+	}
+	sort.Slice(identities, func(i, j int) bool {
+		return forgeKey(identities[i].Forge, identities[i].Username) < forgeKey(identities[j].Forge, identities[j].Username)
+	})
+	return identities, nil
+}
+
+func (s *InMemStorage) GetForgeIdentity(forge, username string) (models.ForgeIdentity, error) {
+	defer s.rlock()()
+
+	identity, ok := s.forgeIdentities[forgeKey(forge, username)]
+	if !ok {
+		return models.ForgeIdentity{}, errs.New(errs.ErrNotFound, "forge identity not found")
+	}
+	return identity, nil
+}
+
+func (s *InMemStorage) GetForgeIdentityByAccount(forge string, accountId int64) (models.ForgeIdentity, error) {
+	defer s.rlock()()
+
+	for _, identity := range s.forgeIdentities {
+		if identity.Forge == forge && identity.AccountId != 0 && identity.AccountId == accountId {
+			return identity, nil
+		}
+	}
+	return models.ForgeIdentity{}, errs.New(errs.ErrNotFound, "forge identity not found")
+}
+
+func (s *InMemStorage) AddAuditEntry(entry models.AuditEntry) error {
+	defer s.lock()()
+
+	save(s, &s.auditSeq)
+	s.auditSeq++
+	entry.Id = s.auditSeq
+	entry.UserIds = append([]string(nil), entry.UserIds...)
+	save(s, &s.audit)
+	s.audit = append(s.audit, entry)
+	return nil
+}
+
+func (s *InMemStorage) AddOutboxEvent(event models.OutboxEvent) error {
+	defer s.lock()()
+
+	for _, e := range s.outbox {
+		if e.EventId == event.EventId {
+			return errs.New(errs.ErrAlreadyExists, "outbox event already exists")
+		}
+	}
+	save(s, &s.outboxSeq)
+	s.outboxSeq++
+	event.Id = s.outboxSeq
+	save(s, &s.outbox)
+	s.outbox = append(s.outbox, event)
+	return nil
+}
+
+func (s *InMemStorage) GetUnpublishedOutboxEvents(sink string, limit int) ([]models.OutboxEvent, error) {
+	defer s.rlock()()
+
+	var events []models.OutboxEvent
+	for _, e := range s.outbox {
+		if len(events) >= limit {
+			break
+		}
+		if !s.outboxPublished[sink][e.Id] {
+			events = append(events, e)
+		}
+	}
+	return events, nil
+}
+
+func (s *InMemStorage) MarkOutboxPublished(sink string, ids []int64) error {
+	defer s.lock()()
+
+	if s.outboxPublished[sink] == nil {
+		saveKey(s, s.outboxPublished, sink)
+		s.outboxPublished[sink] = make(map[int64]bool)
+	}
+	for _, id := range ids {
+		saveKey(s, s.outboxPublished[sink], id)
+		s.outboxPublished[sink][id] = true
+	}
+	return nil
+}
+
+func (s *InMemStorage) GetAuditEntries(filter models.AuditFilter) ([]models.AuditEntry, error) {
+	defer s.rlock()()
+
+	var entries []models.AuditEntry
+	for i := len(s.audit) - 1; i >= 0; i-- {
+		e := s.audit[i]
+		if (filter.PullRequestId != "" && e.PullRequestId != filter.PullRequestId) ||
+			(filter.UserId != "" && !slices.Contains(e.UserIds, filter.UserId)) ||
+			(filter.TeamName != "" && e.TeamName != filter.TeamName) ||
+			(!filter.From.IsZero() && e.OccurredAt.Before(filter.From)) ||
+			(!filter.To.IsZero() && !e.OccurredAt.Before(filter.To)) {
+			continue
+		}
+		if filter.Limit > 0 && len(entries) >= filter.Limit {
+			break
+		}
+		entries = append(entries, e)
+	}
+	return entries, nil
+}
+
+func (s *InMemStorage) AddAbsence(absence models.Absence) (models.Absence, error) {
+	defer s.lock()()
+
+	if _, ok := s.users[absence.UserId]; !ok {
+		return models.Absence{}, errs.New(errs.ErrNotFound, "user not found")
+	}
+	save(s, &s.absenceSeq)
+	s.absenceSeq++
+	absence.Id = s.absenceSeq
+	absence.Processed = false
+	save(s, &s.absences)
+	s.absences = append(s.absences, absence)
+	return absence, nil
+}
+
+func (s *InMemStorage) GetAbsence(id int64) (models.Absence, error) {
+	defer s.rlock()()
+
+	for _, a := range s.absences {
+		if a.Id == id {
+			return a, nil
+		}
+	}
+	return models.Absence{}, errs.New(errs.ErrNotFound, "absence not found")
+}
+
+func (s *InMemStorage) DeleteAbsence(id int64) error {
+	defer s.lock()()
+
+	for i, a := range s.absences {
+		if a.Id == id {
+			save(s, &s.absences)
+			s.absences = append(s.absences[:i:i], s.absences[i+1:]...)
+			return nil
+		}
+	}
+	return errs.New(errs.ErrNotFound, "absence not found")
+}
+
+func (s *InMemStorage) GetAbsences(userId string) ([]models.Absence, error) {
+	defer s.rlock()()
+
+	return s.filterAbsences(func(a models.Absence) bool { return a.UserId == userId }), nil
+}
+
+func (s *InMemStorage) GetAbsencesAt(at time.Time) ([]models.Absence, error) {
+	defer s.rlock()()
+
+	return s.filterAbsences(func(a models.Absence) bool { return !a.StartsAt.After(at) && a.EndsAt.After(at) }), nil
+}
+
+func (s *InMemStorage) filterAbsences(match func(models.Absence) bool) []models.Absence {
+	var absences []models.Absence
+	for _, a := range s.absences {
+		if match(a) {
+			absences = append(absences, a)
+		}
+	}
+	sort.SliceStable(absences, func(i, j int) bool {
+		return absences[i].StartsAt.Before(absences[j].StartsAt)
+	})
+	return absences
+}
+
+func (s *InMemStorage) MarkAbsenceProcessed(id int64) error {
+	defer s.lock()()
+
+	for i := range s.absences {
+		if s.absences[i].Id == id {
+			save(s, &s.absences[i])
+			s.absences[i].Processed = true
+		}
+	}
+	return nil
+}
+
+func (s *InMemStorage) SetWorkingHours(hours models.WorkingHours) error {
+	defer s.lock()()
+
+	if _, ok := s.users[hours.UserId]; !ok {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	hours.Weekdays = append([]int(nil), hours.Weekdays...)
+	saveKey(s, s.hours, hours.UserId)
+	s.hours[hours.UserId] = hours
+	return nil
+}
+
+func (s *InMemStorage) DeleteWorkingHours(userId string) error {
+	defer s.lock()()
+
+	saveKey(s, s.hours, userId)
+	delete(s.hours, userId)
+	return nil
+}
+
+func (s *InMemStorage) GetWorkingHours(userIds []string) (map[string]models.WorkingHours, error) {
+	defer s.rlock()()
+
+	schedules := make(map[string]models.WorkingHours, len(userIds))
+	for _, id := range userIds {
+		if h, ok := s.hours[id]; ok {
+			schedules[id] = h
+		}
+	}
+	return schedules, nil
+}
+
+func (s *InMemStorage) GetCodeOwnerRules() ([]models.CodeOwnerRule, error) {
+	defer s.rlock()()
+
+	return append([]models.CodeOwnerRule(nil), s.codeOwners...), nil
+}
+
+func (s *InMemStorage) SaveCodeOwnerRule(rule models.CodeOwnerRule) error {
+	defer s.lock()()
+
+	rule.Users = append([]string(nil), rule.Users...)
+	rule.Teams = append([]string(nil), rule.Teams...)
+	for i, r := range s.codeOwners {
+		if r.Pattern == rule.Pattern {
+			save(s, &s.codeOwners[i])
+			s.codeOwners[i] = rule
+			return nil
+		}
+	}
+	save(s, &s.codeOwners)
+	s.codeOwners = append(s.codeOwners, rule)
+	return nil
+}
+
+func (s *InMemStorage) DeleteCodeOwnerRule(pattern string) error {
+	defer s.lock()()
+
+	for i, r := range s.codeOwners {
+		if r.Pattern == pattern {
+			save(s, &s.codeOwners)
+			s.codeOwners = append(s.codeOwners[:i:i], s.codeOwners[i+1:]...)
+			return nil
+		}
+	}
+	return errs.New(errs.ErrNotFound, "code owner rule not found")
+}
+
+func (s *InMemStorage) GetTeamSettings(teamName string) (models.TeamSettings, error) {
+	defer s.rlock()()
+
+	settings, ok := s.teams[teamName]
+	if !ok {
+		return models.TeamSettings{}, errs.New(errs.ErrNotFound, "team not found")
+	}
+	return settings, nil
+}
+
+func (s *InMemStorage) UpdateTeamSettings(settings models.TeamSettings) error {
+	defer s.lock()()
+
+	old, ok := s.teams[settings.TeamName]
+	if !ok || old.Version != settings.Version {
+		return repository.ErrConflict
+	}
+	settings.FallbackTeams = append([]string(nil), settings.FallbackTeams...)
+	settings.Version++
+	saveKey(s, s.teams, settings.TeamName)
+	s.teams[settings.TeamName] = settings
+	return nil
+}
+
+func (s *InMemStorage) GetRoundRobinCursor(teamName string) (string, error) {
+	defer s.rlock()()
+
+	if _, ok := s.teams[teamName]; !ok {
+		return "", errs.New(errs.ErrNotFound, "team not found")
+	}
+	return s.rrCursors[teamName], nil
+}
+
+func (s *InMemStorage) SetRoundRobinCursor(teamName, userId string) error {
+	defer s.lock()()
+
+	saveKey(s, s.rrCursors, teamName)
+	s.rrCursors[teamName] = userId
+	return nil
+}
+
+func (s *InMemStorage) activeReviewers(prId string) []string {
+	var reviewers []string
+	for _, a := range s.assignments[prId] {
+		if a.UnassignedAt == nil {
+			reviewers = append(reviewers, a.UserId)
+		}
+	}
+	return reviewers
+}
@@ -0,0 +1,215 @@
+package storage
+
+import (
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInMemStorage_Teams(t *testing.T) {
+	s := NewInMemStorage()
+
+	members := []models.TeamMember{
+		{UserId: "u1", Username: "user1", IsActive: true},
+		{UserId: "u2", Username: "user2", IsActive: false},
+	}
+	require.NoError(t, s.AddTeam("team1", members))
+
+	got, err := s.GetTeam("team1")
+	require.NoError(t, err)
+	assert.Equal(t, members, got)
+
+	assert.Error(t, s.AddTeam("team1", nil))
+	assert.Error(t, s.AddTeam("team2", []models.TeamMember{{UserId: "u3"}, {UserId: "u1"}}))
+	_, err = s.GetTeam("team2")
+	assert.EqualError(t, err, "team not found")
+
+	require.NoError(t, s.DeactivateTeam("team1"))
+	users, err := s.GetUsersByTeam("team1")
+	require.NoError(t, err)
+	for _, u := range users {
+		assert.False(t, u.IsActive)
+	}
+	assert.EqualError(t, s.DeactivateTeam("unknown"), "team not found or no users to deactivate")
+}
+
+func TestInMemStorage_Users(t *testing.T) {
+	s := NewInMemStorage()
+	require.NoError(t, s.AddTeam("team1", []models.TeamMember{{UserId: "u1", Username: "user1", IsActive: true}}))
+
+	require.NoError(t, s.SetUserActive("u1", false))
+	u, err := s.GetUser("u1")
+	require.NoError(t, err)
+	assert.Equal(t, models.User{UserId: "u1", Username: "user1", TeamName: "team1", IsActive: false, ReviewWeight: 1}, u)
+
+	_, err = s.GetUser("unknown")
+	assert.EqualError(t, err, "user not found")
+	assert.EqualError(t, s.SetUserActive("unknown", true), "user not found")
+}
+
+func TestInMemStorage_PullRequests(t *testing.T) {
+	s := NewInMemStorage()
+	require.NoError(t, s.AddTeam("team1", []models.TeamMember{
+		{UserId: "u1", Username: "user1", IsActive: true},
+		{UserId: "u2", Username: "user2", IsActive: true},
+		{UserId: "u3", Username: "user3", IsActive: true},
+	}))
+
+	now := time.Now()
+	pr := models.PullRequest{
+		PullRequestId:     "pr1",
+		PullRequestName:   "PR 1",
+		AuthorId:          "u1",
+		Status:            "OPEN",
+		AssignedReviewers: []string{"u2", "u3"},
+		CreatedAt:         &now,
+	}
+	require.NoError(t, s.CreatePR(pr))
+	assert.Error(t, s.CreatePR(pr))
+	require.NoError(t, s.CreatePR(models.PullRequest{
+		PullRequestId:     "pr2",
+		PullRequestName:   "PR 2",
+		AuthorId:          "u1",
+		Status:            "OPEN",
+		AssignedReviewers: []string{"u2"},
+		CreatedAt:         &now,
+	}))
+
+	got, err := s.GetPR("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, pr, got)
+
+	// Изменение полученной копии не должно влиять на хранилище
+	got.AssignedReviewers[0] = "u1"
+	again, err := s.GetPR("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2", "u3"}, again.AssignedReviewers)
+
+	again.Status = "MERGED"
+	again.MergedAt = &now
+	require.NoError(t, s.UpdatePR(again))
+	merged, err := s.GetPR("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, "MERGED", merged.Status)
+	assert.Equal(t, 1, merged.Version)
+	// Повторное обновление по устаревшей версии отклоняется
+	assert.ErrorIs(t, s.UpdatePR(again), repository.ErrConflict)
+
+	_, err = s.GetPR("unknown")
+	assert.EqualError(t, err, "PR not found")
+
+	prs, err := s.GetPRsByReviewer("u2")
+	require.NoError(t, err)
+	assert.Equal(t, []models.PullRequestShort{
+		{PullRequestId: "pr1", PullRequestName: "PR 1", AuthorId: "u1", Status: "MERGED"},
+		{PullRequestId: "pr2", PullRequestName: "PR 2", AuthorId: "u1", Status: "OPEN"},
+	}, prs)
+
+	stats, err := s.GetAssignmentStats()
+	require.NoError(t, err)
+	assert.Equal(t, []models.AssignmentStat{
+		{UserId: "u2", Count: 2},
+		{UserId: "u3", Count: 1},
+	}, stats)
+}
+
+func TestInMemStorage_UpdateTeamSettings(t *testing.T) {
+	s := NewInMemStorage()
+	require.NoError(t, s.AddTeam("team1", []models.TeamMember{{UserId: "u1", Username: "user1", IsActive: true}}))
+
+	settings, err := s.GetTeamSettings("team1")
+	require.NoError(t, err)
+	stale := settings
+	settings.MinApprovals = 1
+	require.NoError(t, s.UpdateTeamSettings(settings))
+
+	// Запись по устаревшей версии не затирает чужое изменение
+	stale.MaxOpenReviews = 3
+	assert.ErrorIs(t, s.UpdateTeamSettings(stale), repository.ErrConflict)
+	settings, err = s.GetTeamSettings("team1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, settings.MinApprovals)
+	assert.Equal(t, 0, settings.MaxOpenReviews)
+	assert.Equal(t, 1, settings.Version)
+}
+
+func TestInMemStorage_WithTx(t *testing.T) {
+	s := NewInMemStorage()
+	require.NoError(t, s.AddTeam("team1", []models.TeamMember{{UserId: "u1", Username: "user1", IsActive: true}}))
+
+	// При ошибке все изменения внутри транзакции откатываются
+	err := s.WithTx(func(repo repository.Repository) error {
+		require.NoError(t, repo.SetUserActive("u1", false))
+		require.NoError(t, repo.AddTeam("team2", []models.TeamMember{{UserId: "u2", Username: "user2", IsActive: true}}))
+		return errors.New("rollback")
+	})
+	assert.EqualError(t, err, "rollback")
+	u, err := s.GetUser("u1")
+	require.NoError(t, err)
+	assert.True(t, u.IsActive)
+	_, err = s.GetTeam("team2")
+	assert.EqualError(t, err, "team not found")
+
+	require.NoError(t, s.WithTx(func(repo repository.Repository) error {
+		return repo.SetUserActive("u1", false)
+	}))
+	u, err = s.GetUser("u1")
+	require.NoError(t, err)
+	assert.False(t, u.IsActive)
+}
+
+func TestInMemStorage_WithTx_RollbackTables(t *testing.T) {
+	s := NewInMemStorage()
+	require.NoError(t, s.AddTeam("team1", []models.TeamMember{
+		{UserId: "u1", Username: "user1", IsActive: true},
+		{UserId: "u2", Username: "user2", IsActive: true},
+		{UserId: "u3", Username: "user3", IsActive: true},
+	}))
+	now := time.Now()
+	require.NoError(t, s.CreatePR(models.PullRequest{PullRequestId: "pr1", AuthorId: "u1", Status: "OPEN", AssignedReviewers: []string{"u2"}, CreatedAt: &now}))
+	absence, err := s.AddAbsence(models.Absence{UserId: "u2", StartsAt: now, EndsAt: now.Add(time.Hour)})
+	require.NoError(t, err)
+	require.NoError(t, s.AddAuditEntry(models.AuditEntry{Action: "before"}))
+
+	err = s.WithTx(func(repo repository.Repository) error {
+		require.NoError(t, repo.UnassignReviewer("pr1", "u2", now))
+		require.NoError(t, repo.AssignReviewer(models.Assignment{PullRequestId: "pr1", UserId: "u3", Role: models.RoleReviewer, AssignedAt: now}))
+		require.NoError(t, repo.CreatePR(models.PullRequest{PullRequestId: "pr2", AuthorId: "u1", Status: "OPEN", CreatedAt: &now}))
+		require.NoError(t, repo.MarkAbsenceProcessed(absence.Id))
+		require.NoError(t, repo.AddAuditEntry(models.AuditEntry{Action: "inside"}))
+		require.NoError(t, repo.AddOutboxEvent(models.OutboxEvent{EventId: "e1"}))
+		return errors.New("rollback")
+	})
+	assert.EqualError(t, err, "rollback")
+
+	pr, err := s.GetPR("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"u2"}, pr.AssignedReviewers)
+	assignments, err := s.GetAssignments("pr1")
+	require.NoError(t, err)
+	require.Len(t, assignments, 1)
+	assert.Nil(t, assignments[0].UnassignedAt)
+	_, err = s.GetPR("pr2")
+	assert.EqualError(t, err, "PR not found")
+	absence, err = s.GetAbsence(absence.Id)
+	require.NoError(t, err)
+	assert.False(t, absence.Processed)
+	entries, err := s.GetAuditEntries(models.AuditFilter{})
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "before", entries[0].Action)
+	events, err := s.GetUnpublishedOutboxEvents("log", 10)
+	require.NoError(t, err)
+	assert.Empty(t, events)
+
+	// Последовательности тоже откатываются, как и записи
+	require.NoError(t, s.AddAuditEntry(models.AuditEntry{Action: "after"}))
+	entries, err = s.GetAuditEntries(models.AuditFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), entries[0].Id)
+}
@@ -1,16 +1,27 @@
 package storage
 
 import (
+	"avito-internship/internal/errs"
 	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
 	"database/sql"
-	"encoding/json"
 	"errors"
+	"time"
 
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 )
 
+var _ repository.Repository = (*Storage)(nil)
+
+type querier interface {
+	Exec(query string, args ...any) (sql.Result, error)
+	Query(query string, args ...any) (*sql.Rows, error)
+	QueryRow(query string, args ...any) *sql.Row
+}
+
 type Storage struct {
 	DB *sql.DB
+	tx *sql.Tx
 }
 
 func NewStorage(connStr string) (*Storage, error) {
@@ -24,31 +35,62 @@ func NewStorage(connStr string) (*Storage, error) {
 	return &Storage{DB: db}, nil
 }
 
-func (s *Storage) AddTeam(teamName string, members []models.TeamMember) error {
+func (s *Storage) WithTx(fn func(repo repository.Repository) error) error {
+	return s.inTx(func(tx *Storage) error {
+		return fn(tx)
+	})
+}
+
+// inTx переиспользует уже открытую транзакцию, поэтому вложенные вызовы коммитятся вместе с внешним
+func (s *Storage) inTx(fn func(tx *Storage) error) error {
+	if s.tx != nil {
+		return fn(s)
+	}
 	tx, err := s.DB.Begin()
 	if err != nil {
 		return err
 	}
 	defer tx.Rollback()
 
-	_, err = tx.Exec("INSERT INTO teams (team_name) VALUES ($1)", teamName)
-	if err != nil {
-		return err
+	if err := fn(&Storage{DB: s.DB, tx: tx}); err != nil {
+		return conflictError(err)
 	}
+	return conflictError(tx.Commit())
+}
 
-	for _, m := range members {
-		_, err = tx.Exec("INSERT INTO users (user_id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
-			m.UserId, m.Username, teamName, m.IsActive)
+func (s *Storage) conn() querier {
+	if s.tx != nil {
+		return s.tx
+	}
+	return s.DB
+}
+
+func (s *Storage) AddTeam(teamName string, members []models.TeamMember) error {
+	return s.inTx(func(tx *Storage) error {
+		_, err := tx.conn().Exec("INSERT INTO teams (team_name) VALUES ($1)", teamName)
 		if err != nil {
+			if pqCode(err) == uniqueViolation {
+				return errs.New(errs.ErrTeamExists, "team already exists")
+			}
 			return err
 		}
-	}
 
-	return tx.Commit()
+		for _, m := range members {
+			_, err = tx.conn().Exec("INSERT INTO users (user_id, username, team_name, is_active) VALUES ($1, $2, $3, $4)",
+				m.UserId, m.Username, teamName, m.IsActive)
+			if err != nil {
+				if pqCode(err) == uniqueViolation {
+					return errs.New(errs.ErrAlreadyExists, "user already exists")
+				}
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (s *Storage) GetTeam(teamName string) ([]models.TeamMember, error) {
-	rows, err := s.DB.Query("SELECT user_id, username, is_active FROM users WHERE team_name = $1", teamName)
+	rows, err := s.conn().Query("SELECT user_id, username, is_active FROM users WHERE team_name = $1", teamName)
 	if err != nil {
 		return nil, err
 	}
@@ -63,13 +105,13 @@ func (s *Storage) GetTeam(teamName string) ([]models.TeamMember, error) {
 		members = append(members, m)
 	}
 	if len(members) == 0 {
-		return nil, errors.New("team not found")
+		return nil, errs.New(errs.ErrNotFound, "team not found")
 	}
 	return members, nil
 }
 
 func (s *Storage) SetUserActive(userId string, isActive bool) error {
-	result, err := s.DB.Exec("UPDATE users SET is_active = $1 WHERE user_id = $2", isActive, userId)
+	result, err := s.conn().Exec("UPDATE users SET is_active = $1 WHERE user_id = $2", isActive, userId)
 	if err != nil {
 		return err
 	}
@@ -78,56 +120,733 @@ func (s *Storage) SetUserActive(userId string, isActive bool) error {
 		return err
 	}
 	if rows == 0 {
-		return errors.New("user not found")
+		return errs.New(errs.ErrNotFound, "user not found")
 	}
 	return nil
 }
 
-func (s *Storage) CreatePR(pr models.PullRequest) error {
-	reviewersJSON, err := json.Marshal(pr.AssignedReviewers)
+func (s *Storage) SetUserLead(userId string, isLead bool) error {
+	result, err := s.conn().Exec("UPDATE users SET is_lead = $1 WHERE user_id = $2", isLead, userId)
 	if err != nil {
 		return err
 	}
-	_, err = s.DB.Exec("INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at) VALUES ($1, $2, $3, $4, $5, $6)",
-		pr.PullRequestId, pr.PullRequestName, pr.AuthorId, pr.Status, reviewersJSON, pr.CreatedAt)
-	return err
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	return nil
+}
+
+func (s *Storage) SetUserReviewWeight(userId string, weight int) error {
+	result, err := s.conn().Exec("UPDATE users SET review_weight = $1 WHERE user_id = $2", weight, userId)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	return nil
+}
+
+func (s *Storage) SetUserMaxOpenReviews(userId string, limit int) error {
+	result, err := s.conn().Exec("UPDATE users SET max_open_reviews = $1 WHERE user_id = $2", limit, userId)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	return nil
+}
+
+func (s *Storage) CreatePR(pr models.PullRequest) error {
+	return s.inTx(func(tx *Storage) error {
+		_, err := tx.conn().Exec("INSERT INTO pull_requests (pull_request_id, pull_request_name, author_id, status, created_at, under_reviewed) VALUES ($1, $2, $3, $4, $5, $6)",
+			pr.PullRequestId, pr.PullRequestName, pr.AuthorId, pr.Status, pr.CreatedAt, pr.UnderReviewed)
+		switch pqCode(err) {
+		case uniqueViolation:
+			return errs.New(errs.ErrPRExists, "PR already exists")
+		case foreignKeyViolation:
+			return errs.New(errs.ErrNotFound, "user not found")
+		}
+		if err != nil {
+			return err
+		}
+		for _, reviewer := range pr.AssignedReviewers {
+			_, err = tx.conn().Exec("INSERT INTO review_assignments (pull_request_id, user_id, role, assigned_at) VALUES ($1, $2, $3, $4)",
+				pr.PullRequestId, reviewer, models.RoleReviewer, pr.CreatedAt)
+			if pqCode(err) == foreignKeyViolation {
+				return errs.New(errs.ErrNotFound, "user not found")
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
 }
 
 func (s *Storage) GetPR(prId string) (models.PullRequest, error) {
 	var pr models.PullRequest
-	var reviewersJSON []byte
-	err := s.DB.QueryRow("SELECT pull_request_id, pull_request_name, author_id, status, assigned_reviewers, created_at, merged_at FROM pull_requests WHERE pull_request_id = $1", prId).
-		Scan(&pr.PullRequestId, &pr.PullRequestName, &pr.AuthorId, &pr.Status, &reviewersJSON, &pr.CreatedAt, &pr.MergedAt)
+	var reviewers pq.StringArray
+	err := s.conn().QueryRow(`
+		SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status, pr.created_at, pr.merged_at, pr.force_merged, pr.under_reviewed, pr.version,
+			array_remove(array_agg(ra.user_id ORDER BY ra.assigned_at, ra.id), NULL)
+		FROM pull_requests pr
+		LEFT JOIN review_assignments ra ON ra.pull_request_id = pr.pull_request_id AND ra.unassigned_at IS NULL
+		WHERE pr.pull_request_id = $1
+		GROUP BY pr.pull_request_id
+	`, prId).Scan(&pr.PullRequestId, &pr.PullRequestName, &pr.AuthorId, &pr.Status, &pr.CreatedAt, &pr.MergedAt, &pr.ForceMerged, &pr.UnderReviewed, &pr.Version, &reviewers)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return models.PullRequest{}, errors.New("PR not found")
+			return models.PullRequest{}, errs.New(errs.ErrNotFound, "PR not found")
 		}
 		return models.PullRequest{}, err
 	}
-	err = json.Unmarshal(reviewersJSON, &pr.AssignedReviewers)
-	if err != nil {
-		return models.PullRequest{}, err
+	if len(reviewers) > 0 {
+		pr.AssignedReviewers = reviewers
 	}
 	return pr, nil
 }
 
+// UpdatePR применяет изменения, только если версия PR не поменялась с момента чтения
 func (s *Storage) UpdatePR(pr models.PullRequest) error {
-	reviewersJSON, err := json.Marshal(pr.AssignedReviewers)
+	result, err := s.conn().Exec("UPDATE pull_requests SET pull_request_name = $1, author_id = $2, status = $3, merged_at = $4, force_merged = $5, version = version + 1 WHERE pull_request_id = $6 AND version = $7",
+		pr.PullRequestName, pr.AuthorId, pr.Status, pr.MergedAt, pr.ForceMerged, pr.PullRequestId, pr.Version)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
 	if err != nil {
 		return err
 	}
-	_, err = s.DB.Exec("UPDATE pull_requests SET pull_request_name = $1, author_id = $2, status = $3, assigned_reviewers = $4, merged_at = $5 WHERE pull_request_id = $6",
-		pr.PullRequestName, pr.AuthorId, pr.Status, reviewersJSON, pr.MergedAt, pr.PullRequestId)
+	if rows == 0 {
+		return repository.ErrConflict
+	}
+	return nil
+}
+
+func (s *Storage) AssignReviewer(assignment models.Assignment) error {
+	_, err := s.conn().Exec("INSERT INTO review_assignments (pull_request_id, user_id, role, assigned_at) VALUES ($1, $2, $3, $4)",
+		assignment.PullRequestId, assignment.UserId, assignment.Role, assignment.AssignedAt)
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch {
+		case pqErr.Code == uniqueViolation:
+			return errs.New(errs.ErrAlreadyExists, "reviewer is already assigned to this PR")
+		case pqErr.Code == foreignKeyViolation && pqErr.Constraint == "review_assignments_pull_request_id_fkey":
+			return errs.New(errs.ErrNotFound, "PR not found")
+		case pqErr.Code == foreignKeyViolation:
+			return errs.New(errs.ErrNotFound, "user not found")
+		}
+	}
+	return err
+}
+
+func (s *Storage) UnassignReviewer(prId, userId string, at time.Time) error {
+	result, err := s.conn().Exec("UPDATE review_assignments SET unassigned_at = $1 WHERE pull_request_id = $2 AND user_id = $3 AND unassigned_at IS NULL",
+		at, prId, userId)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotAssigned, "reviewer is not assigned to this PR")
+	}
+	return nil
+}
+
+func (s *Storage) GetAssignments(prId string) ([]models.Assignment, error) {
+	rows, err := s.conn().Query("SELECT pull_request_id, user_id, role, assigned_at, unassigned_at, overdue_at FROM review_assignments WHERE pull_request_id = $1 ORDER BY assigned_at, id", prId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []models.Assignment
+	for rows.Next() {
+		var a models.Assignment
+		if err := rows.Scan(&a.PullRequestId, &a.UserId, &a.Role, &a.AssignedAt, &a.UnassignedAt, &a.OverdueAt); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, a)
+	}
+	return assignments, rows.Err()
+}
+
+func (s *Storage) GetUnansweredAssignments() ([]models.Assignment, error) {
+	rows, err := s.conn().Query(`
+		SELECT ra.pull_request_id, ra.user_id, ra.role, ra.assigned_at, ra.overdue_at, ra.reassign_failed_at
+		FROM review_assignments ra
+		JOIN pull_requests pr ON pr.pull_request_id = ra.pull_request_id AND pr.status = 'OPEN'
+		WHERE ra.unassigned_at IS NULL AND ra.role = $1
+		  AND NOT EXISTS (
+			SELECT 1 FROM reviews r
+			WHERE r.pull_request_id = ra.pull_request_id AND r.reviewer_id = ra.user_id AND r.submitted_at >= ra.assigned_at
+		  )
+		ORDER BY ra.assigned_at, ra.id
+	`, models.RoleReviewer)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var assignments []models.Assignment
+	for rows.Next() {
+		var a models.Assignment
+		if err := rows.Scan(&a.PullRequestId, &a.UserId, &a.Role, &a.AssignedAt, &a.OverdueAt, &a.ReassignFailedAt); err != nil {
+			return nil, err
+		}
+		assignments = append(assignments, a)
+	}
+	return assignments, rows.Err()
+}
+
+func (s *Storage) MarkAssignmentOverdue(prId, userId string, at time.Time) error {
+	return s.markAssignment("overdue_at", prId, userId, at)
+}
+
+func (s *Storage) MarkReassignFailed(prId, userId string, at time.Time) error {
+	return s.markAssignment("reassign_failed_at", prId, userId, at)
+}
+
+// markAssignment записывает время at в столбец column текущего назначения ревьювера
+func (s *Storage) markAssignment(column, prId, userId string, at time.Time) error {
+	result, err := s.conn().Exec("UPDATE review_assignments SET "+column+" = $1 WHERE pull_request_id = $2 AND user_id = $3 AND unassigned_at IS NULL",
+		at, prId, userId)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotAssigned, "reviewer is not assigned to this PR")
+	}
+	return nil
+}
+
+func (s *Storage) AddReview(review models.Review) error {
+	_, err := s.conn().Exec("INSERT INTO reviews (pull_request_id, reviewer_id, state, body, submitted_at) VALUES ($1, $2, $3, $4, $5)",
+		review.PullRequestId, review.ReviewerId, review.State, review.Body, review.SubmittedAt)
+	return err
+}
+
+func (s *Storage) AddPREvent(event models.PullRequestEvent) error {
+	_, err := s.conn().Exec(`
+		INSERT INTO pull_request_events (pull_request_id, type, occurred_at, actor, user_id, reason, pull_request_name, review_state, force_merged)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`, event.PullRequestId, event.Type, event.OccurredAt, event.Actor, event.UserId, event.Reason, event.PullRequestName, event.ReviewState, event.ForceMerged)
+	if pqCode(err) == foreignKeyViolation {
+		return errs.New(errs.ErrNotFound, "PR not found")
+	}
+	return err
+}
+
+func (s *Storage) GetPREvents(prId string) ([]models.PullRequestEvent, error) {
+	rows, err := s.conn().Query(`
+		SELECT pull_request_id, type, occurred_at, actor, user_id, reason, pull_request_name, review_state, force_merged
+		FROM pull_request_events WHERE pull_request_id = $1 ORDER BY id
+	`, prId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []models.PullRequestEvent
+	for rows.Next() {
+		var e models.PullRequestEvent
+		if err := rows.Scan(&e.PullRequestId, &e.Type, &e.OccurredAt, &e.Actor, &e.UserId, &e.Reason, &e.PullRequestName,
+			&e.ReviewState, &e.ForceMerged); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+func (s *Storage) GetReviews(prId string) ([]models.Review, error) {
+	rows, err := s.conn().Query("SELECT pull_request_id, reviewer_id, state, body, submitted_at FROM reviews WHERE pull_request_id = $1 ORDER BY submitted_at, id", prId)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviews []models.Review
+	for rows.Next() {
+		var r models.Review
+		if err := rows.Scan(&r.PullRequestId, &r.ReviewerId, &r.State, &r.Body, &r.SubmittedAt); err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, r)
+	}
+	return reviews, rows.Err()
+}
+
+func (s *Storage) AddAbsence(absence models.Absence) (models.Absence, error) {
+	err := s.conn().QueryRow("INSERT INTO user_absences (user_id, kind, starts_at, ends_at) VALUES ($1, $2, $3, $4) RETURNING id",
+		absence.UserId, absence.Kind, absence.StartsAt, absence.EndsAt).Scan(&absence.Id)
+	if pqCode(err) == foreignKeyViolation {
+		return models.Absence{}, errs.New(errs.ErrNotFound, "user not found")
+	}
+	if err != nil {
+		return models.Absence{}, err
+	}
+	return absence, nil
+}
+
+func (s *Storage) DeleteAbsence(id int64) error {
+	result, err := s.conn().Exec("DELETE FROM user_absences WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotFound, "absence not found")
+	}
+	return nil
+}
+
+func (s *Storage) GetAbsence(id int64) (models.Absence, error) {
+	absences, err := s.queryAbsences("SELECT id, user_id, kind, starts_at, ends_at, processed FROM user_absences WHERE id = $1", id)
+	if err != nil {
+		return models.Absence{}, err
+	}
+	if len(absences) == 0 {
+		return models.Absence{}, errs.New(errs.ErrNotFound, "absence not found")
+	}
+	return absences[0], nil
+}
+
+func (s *Storage) GetAbsences(userId string) ([]models.Absence, error) {
+	return s.queryAbsences("SELECT id, user_id, kind, starts_at, ends_at, processed FROM user_absences WHERE user_id = $1 ORDER BY starts_at, id", userId)
+}
+
+func (s *Storage) GetAbsencesAt(at time.Time) ([]models.Absence, error) {
+	return s.queryAbsences("SELECT id, user_id, kind, starts_at, ends_at, processed FROM user_absences WHERE starts_at <= $1 AND ends_at > $1 ORDER BY starts_at, id", at)
+}
+
+func (s *Storage) queryAbsences(query string, args ...any) ([]models.Absence, error) {
+	rows, err := s.conn().Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var absences []models.Absence
+	for rows.Next() {
+		var a models.Absence
+		if err := rows.Scan(&a.Id, &a.UserId, &a.Kind, &a.StartsAt, &a.EndsAt, &a.Processed); err != nil {
+			return nil, err
+		}
+		absences = append(absences, a)
+	}
+	return absences, rows.Err()
+}
+
+func (s *Storage) MarkAbsenceProcessed(id int64) error {
+	_, err := s.conn().Exec("UPDATE user_absences SET processed = TRUE WHERE id = $1", id)
+	return err
+}
+
+func (s *Storage) SetWorkingHours(hours models.WorkingHours) error {
+	_, err := s.conn().Exec(`
+		INSERT INTO user_working_hours (user_id, timezone, weekdays, start_time, end_time) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE SET timezone = EXCLUDED.timezone, weekdays = EXCLUDED.weekdays,
+			start_time = EXCLUDED.start_time, end_time = EXCLUDED.end_time
+	`, hours.UserId, hours.Timezone, pq.Array(hours.Weekdays), hours.Start, hours.End)
+	if pqCode(err) == foreignKeyViolation {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	return err
+}
+
+func (s *Storage) DeleteWorkingHours(userId string) error {
+	_, err := s.conn().Exec("DELETE FROM user_working_hours WHERE user_id = $1", userId)
+	return err
+}
+
+func (s *Storage) GetWorkingHours(userIds []string) (map[string]models.WorkingHours, error) {
+	rows, err := s.conn().Query("SELECT user_id, timezone, weekdays, start_time, end_time FROM user_working_hours WHERE user_id = ANY($1)", pq.Array(userIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	schedules := make(map[string]models.WorkingHours, len(userIds))
+	for rows.Next() {
+		var h models.WorkingHours
+		var weekdays pq.Int64Array
+		if err := rows.Scan(&h.UserId, &h.Timezone, &weekdays, &h.Start, &h.End); err != nil {
+			return nil, err
+		}
+		for _, d := range weekdays {
+			h.Weekdays = append(h.Weekdays, int(d))
+		}
+		schedules[h.UserId] = h
+	}
+	return schedules, rows.Err()
+}
+
+func (s *Storage) GetCodeOwnerRules() ([]models.CodeOwnerRule, error) {
+	rows, err := s.conn().Query("SELECT pattern, users, teams FROM code_owner_rules ORDER BY id")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rules []models.CodeOwnerRule
+	for rows.Next() {
+		var rule models.CodeOwnerRule
+		var users, teams pq.StringArray
+		if err := rows.Scan(&rule.Pattern, &users, &teams); err != nil {
+			return nil, err
+		}
+		rule.Users, rule.Teams = users, teams
+		rules = append(rules, rule)
+	}
+	return rules, rows.Err()
+}
+
+func (s *Storage) SaveCodeOwnerRule(rule models.CodeOwnerRule) error {
+	_, err := s.conn().Exec(`
+		INSERT INTO code_owner_rules (pattern, users, teams) VALUES ($1, COALESCE($2::TEXT[], '{}'), COALESCE($3::TEXT[], '{}'))
+		ON CONFLICT (pattern) DO UPDATE SET users = EXCLUDED.users, teams = EXCLUDED.teams
+	`, rule.Pattern, pq.StringArray(rule.Users), pq.StringArray(rule.Teams))
+	return err
+}
+
+func (s *Storage) DeleteCodeOwnerRule(pattern string) error {
+	result, err := s.conn().Exec("DELETE FROM code_owner_rules WHERE pattern = $1", pattern)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotFound, "code owner rule not found")
+	}
+	return nil
+}
+
+func (s *Storage) CreateWebhook(webhook models.WebhookSubscription) (models.WebhookSubscription, error) {
+	err := s.conn().QueryRow("INSERT INTO webhooks (url, events, secret, created_at) VALUES ($1, $2, $3, $4) RETURNING id",
+		webhook.URL, pq.StringArray(webhook.Events), webhook.Secret, webhook.CreatedAt).Scan(&webhook.Id)
+	if err != nil {
+		return models.WebhookSubscription{}, err
+	}
+	return webhook, nil
+}
+
+func (s *Storage) GetWebhook(id int64) (models.WebhookSubscription, error) {
+	webhooks, err := s.queryWebhooks("SELECT id, url, events, secret, created_at FROM webhooks WHERE id = $1", id)
+	if err != nil {
+		return models.WebhookSubscription{}, err
+	}
+	if len(webhooks) == 0 {
+		return models.WebhookSubscription{}, errs.New(errs.ErrNotFound, "webhook not found")
+	}
+	return webhooks[0], nil
+}
+
+func (s *Storage) GetWebhooks() ([]models.WebhookSubscription, error) {
+	return s.queryWebhooks("SELECT id, url, events, secret, created_at FROM webhooks ORDER BY id")
+}
+
+func (s *Storage) queryWebhooks(query string, args ...any) ([]models.WebhookSubscription, error) {
+	rows, err := s.conn().Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var webhooks []models.WebhookSubscription
+	for rows.Next() {
+		var w models.WebhookSubscription
+		var events pq.StringArray
+		if err := rows.Scan(&w.Id, &w.URL, &events, &w.Secret, &w.CreatedAt); err != nil {
+			return nil, err
+		}
+		w.Events = events
+		webhooks = append(webhooks, w)
+	}
+	return webhooks, rows.Err()
+}
+
+func (s *Storage) DeleteWebhook(id int64) error {
+	result, err := s.conn().Exec("DELETE FROM webhooks WHERE id = $1", id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotFound, "webhook not found")
+	}
+	return nil
+}
+
+func (s *Storage) AddDelivery(delivery models.WebhookDelivery) (models.WebhookDelivery, error) {
+	err := s.conn().QueryRow(`
+		INSERT INTO webhook_deliveries (webhook_id, event_id, event, payload, status, next_attempt_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id
+	`, delivery.WebhookId, delivery.EventId, delivery.Event, delivery.Payload, delivery.Status, delivery.NextAttemptAt, delivery.CreatedAt).Scan(&delivery.Id)
+	if pqCode(err) == foreignKeyViolation {
+		return models.WebhookDelivery{}, errs.New(errs.ErrNotFound, "webhook not found")
+	}
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	return delivery, nil
+}
+
+const deliveryColumns = "id, webhook_id, event_id, event, payload, status, attempts, next_attempt_at, last_error, response_status, created_at, delivered_at"
+
+func (s *Storage) GetDelivery(id int64) (models.WebhookDelivery, error) {
+	deliveries, err := s.queryDeliveries("SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE id = $1", id)
+	if err != nil {
+		return models.WebhookDelivery{}, err
+	}
+	if len(deliveries) == 0 {
+		return models.WebhookDelivery{}, errs.New(errs.ErrNotFound, "delivery not found")
+	}
+	return deliveries[0], nil
+}
+
+func (s *Storage) GetDeliveries(filter models.DeliveryFilter) ([]models.WebhookDelivery, error) {
+	limit := sql.NullInt64{Int64: int64(filter.Limit), Valid: filter.Limit > 0}
+	return s.queryDeliveries("SELECT "+deliveryColumns+` FROM webhook_deliveries
+		WHERE ($1 = 0 OR webhook_id = $1) AND ($2 = '' OR status = $2)
+		ORDER BY id DESC LIMIT $3`, filter.WebhookId, filter.Status, limit)
+}
+
+func (s *Storage) GetDueDeliveries(at time.Time, limit int) ([]models.WebhookDelivery, error) {
+	return s.queryDeliveries("SELECT "+deliveryColumns+" FROM webhook_deliveries WHERE status = $1 AND next_attempt_at <= $2 ORDER BY next_attempt_at, id LIMIT $3",
+		models.DeliveryPending, at, limit)
+}
+
+func (s *Storage) queryDeliveries(query string, args ...any) ([]models.WebhookDelivery, error) {
+	rows, err := s.conn().Query(query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var deliveries []models.WebhookDelivery
+	for rows.Next() {
+		var d models.WebhookDelivery
+		if err := rows.Scan(&d.Id, &d.WebhookId, &d.EventId, &d.Event, &d.Payload, &d.Status, &d.Attempts, &d.NextAttemptAt,
+			&d.LastError, &d.ResponseStatus, &d.CreatedAt, &d.DeliveredAt); err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, rows.Err()
+}
+
+func (s *Storage) UpdateDelivery(delivery models.WebhookDelivery) error {
+	result, err := s.conn().Exec(`
+		UPDATE webhook_deliveries SET status = $1, attempts = $2, next_attempt_at = $3, last_error = $4, response_status = $5, delivered_at = $6
+		WHERE id = $7
+	`, delivery.Status, delivery.Attempts, delivery.NextAttemptAt, delivery.LastError, delivery.ResponseStatus, delivery.DeliveredAt, delivery.Id)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotFound, "delivery not found")
+	}
+	return nil
+}
+
+func (s *Storage) SetForgeIdentity(identity models.ForgeIdentity) error {
+	_, err := s.conn().Exec(`
+		INSERT INTO forge_identities (forge, username, user_id, account_id) VALUES ($1, $2, $3, NULLIF($4, 0))
+		ON CONFLICT (forge, username) DO UPDATE SET user_id = EXCLUDED.user_id, account_id = EXCLUDED.account_id
+	`, identity.Forge, identity.Username, identity.UserId, identity.AccountId)
+	if pqCode(err) == foreignKeyViolation {
+		return errs.New(errs.ErrNotFound, "user not found")
+	}
+	return err
+}
+
+func (s *Storage) DeleteForgeIdentity(forge, username string) error {
+	result, err := s.conn().Exec("DELETE FROM forge_identities WHERE forge = $1 AND username = $2", forge, username)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotFound, "forge identity not found")
+	}
+	return nil
+}
+
+func (s *Storage) GetForgeIdentities() ([]models.ForgeIdentity, error) {
+	rows, err := s.conn().Query("SELECT forge, username, user_id, COALESCE(account_id, 0) FROM forge_identities ORDER BY forge, username")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var identities []models.ForgeIdentity
+	for rows.Next() {
+		var i models.ForgeIdentity
+		if err := rows.Scan(&i.Forge, &i.Username, &i.UserId, &i.AccountId); err != nil {
+			return nil, err
+		}
+		identities = append(identities, i)
+	}
+	return identities, rows.Err()
+}
+
+func (s *Storage) GetForgeIdentity(forge, username string) (models.ForgeIdentity, error) {
+	identity := models.ForgeIdentity{Forge: forge, Username: username}
+	err := s.conn().QueryRow("SELECT user_id, COALESCE(account_id, 0) FROM forge_identities WHERE forge = $1 AND username = $2", forge, username).
+		Scan(&identity.UserId, &identity.AccountId)
+	if err == sql.ErrNoRows {
+		return models.ForgeIdentity{}, errs.New(errs.ErrNotFound, "forge identity not found")
+	}
+	if err != nil {
+		return models.ForgeIdentity{}, err
+	}
+	return identity, nil
+}
+
+func (s *Storage) GetForgeIdentityByAccount(forge string, accountId int64) (models.ForgeIdentity, error) {
+	identity := models.ForgeIdentity{Forge: forge, AccountId: accountId}
+	err := s.conn().QueryRow("SELECT username, user_id FROM forge_identities WHERE forge = $1 AND account_id = $2", forge, accountId).
+		Scan(&identity.Username, &identity.UserId)
+	if err == sql.ErrNoRows {
+		return models.ForgeIdentity{}, errs.New(errs.ErrNotFound, "forge identity not found")
+	}
+	if err != nil {
+		return models.ForgeIdentity{}, err
+	}
+	return identity, nil
+}
+
+func (s *Storage) AddAuditEntry(entry models.AuditEntry) error {
+	_, err := s.conn().Exec(`
+		INSERT INTO audit_log (occurred_at, actor, action, target_type, target_id, pull_request_id, user_ids, team_name, reason, error,
+			state_before, state_after)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`, entry.OccurredAt, entry.Actor, entry.Action, entry.TargetType, entry.TargetId, entry.PullRequestId, pq.StringArray(entry.UserIds),
+		entry.TeamName, entry.Reason, entry.Error, nullJSON(entry.Before), nullJSON(entry.After))
+	return err
+}
+
+// nullJSON передаёт отсутствующий снимок как NULL, а не пустую строку, которая не является JSON
+func nullJSON(b []byte) any {
+	if b == nil {
+		return nil
+	}
+	return b
+}
+
+func (s *Storage) GetAuditEntries(filter models.AuditFilter) ([]models.AuditEntry, error) {
+	from := sql.NullTime{Time: filter.From, Valid: !filter.From.IsZero()}
+	to := sql.NullTime{Time: filter.To, Valid: !filter.To.IsZero()}
+	limit := sql.NullInt64{Int64: int64(filter.Limit), Valid: filter.Limit > 0}
+	rows, err := s.conn().Query(`
+		SELECT id, occurred_at, actor, action, target_type, target_id, pull_request_id, user_ids, team_name, reason, error,
+			state_before, state_after
+		FROM audit_log
+		WHERE ($1 = '' OR pull_request_id = $1) AND ($2 = '' OR $2 = ANY(user_ids)) AND ($3 = '' OR team_name = $3)
+			AND ($4::timestamptz IS NULL OR occurred_at >= $4) AND ($5::timestamptz IS NULL OR occurred_at < $5)
+		ORDER BY id DESC LIMIT $6
+	`, filter.PullRequestId, filter.UserId, filter.TeamName, from, to, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []models.AuditEntry
+	for rows.Next() {
+		var e models.AuditEntry
+		var userIds pq.StringArray
+		if err := rows.Scan(&e.Id, &e.OccurredAt, &e.Actor, &e.Action, &e.TargetType, &e.TargetId, &e.PullRequestId, &userIds,
+			&e.TeamName, &e.Reason, &e.Error, &e.Before, &e.After); err != nil {
+			return nil, err
+		}
+		e.UserIds = userIds
+		entries = append(entries, e)
+	}
+	return entries, rows.Err()
+}
+
+func (s *Storage) AddOutboxEvent(event models.OutboxEvent) error {
+	_, err := s.conn().Exec("INSERT INTO outbox (event_id, event, payload, occurred_at) VALUES ($1, $2, $3, $4)",
+		event.EventId, event.Event, event.Payload, event.OccurredAt)
+	return err
+}
+
+func (s *Storage) GetUnpublishedOutboxEvents(sink string, limit int) ([]models.OutboxEvent, error) {
+	rows, err := s.conn().Query(`
+		SELECT o.id, o.event_id, o.event, o.payload, o.occurred_at
+		FROM outbox o
+		WHERE NOT EXISTS (SELECT 1 FROM outbox_published p WHERE p.sink = $1 AND p.outbox_id = o.id)
+		ORDER BY o.id LIMIT $2
+	`, sink, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []models.OutboxEvent
+	for rows.Next() {
+		var e models.OutboxEvent
+		if err := rows.Scan(&e.Id, &e.EventId, &e.Event, &e.Payload, &e.OccurredAt); err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, rows.Err()
+}
+
+func (s *Storage) MarkOutboxPublished(sink string, ids []int64) error {
+	_, err := s.conn().Exec(`
+		INSERT INTO outbox_published (outbox_id, sink) SELECT unnest($1::bigint[]), $2
+		ON CONFLICT DO NOTHING
+	`, pq.Int64Array(ids), sink)
 	return err
 }
 
 func (s *Storage) GetUser(userId string) (models.User, error) {
 	var u models.User
-	err := s.DB.QueryRow("SELECT user_id, username, team_name, is_active FROM users WHERE user_id = $1", userId).
-		Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive)
+	err := s.conn().QueryRow("SELECT user_id, username, team_name, is_active, review_weight, is_lead, max_open_reviews FROM users WHERE user_id = $1", userId).
+		Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive, &u.ReviewWeight, &u.IsLead, &u.MaxOpenReviews)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return models.User{}, errors.New("user not found")
+			return models.User{}, errs.New(errs.ErrNotFound, "user not found")
 		}
 		return models.User{}, err
 	}
@@ -135,7 +854,7 @@ func (s *Storage) GetUser(userId string) (models.User, error) {
 }
 
 func (s *Storage) GetUsersByTeam(teamName string) ([]models.User, error) {
-	rows, err := s.DB.Query("SELECT user_id, username, team_name, is_active FROM users WHERE team_name = $1", teamName)
+	rows, err := s.conn().Query("SELECT user_id, username, team_name, is_active, review_weight, is_lead, max_open_reviews FROM users WHERE team_name = $1", teamName)
 	if err != nil {
 		return nil, err
 	}
@@ -144,7 +863,7 @@ func (s *Storage) GetUsersByTeam(teamName string) ([]models.User, error) {
 	var users []models.User
 	for rows.Next() {
 		var u models.User
-		if err := rows.Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive); err != nil {
+		if err := rows.Scan(&u.UserId, &u.Username, &u.TeamName, &u.IsActive, &u.ReviewWeight, &u.IsLead, &u.MaxOpenReviews); err != nil {
 			return nil, err
 		}
 		users = append(users, u)
@@ -153,14 +872,12 @@ func (s *Storage) GetUsersByTeam(teamName string) ([]models.User, error) {
 }
 
 func (s *Storage) GetPRsByReviewer(userId string) ([]models.PullRequestShort, error) {
-	rows, err := s.DB.Query(`
+	rows, err := s.conn().Query(`
 		SELECT pr.pull_request_id, pr.pull_request_name, pr.author_id, pr.status
-		FROM pull_requests pr
-		WHERE EXISTS (
-			SELECT 1
-			FROM jsonb_array_elements_text(pr.assigned_reviewers) AS elem
-			WHERE elem = $1
-		)
+		FROM review_assignments ra
+		JOIN pull_requests pr ON pr.pull_request_id = ra.pull_request_id
+		WHERE ra.user_id = $1 AND ra.unassigned_at IS NULL
+		ORDER BY ra.assigned_at, ra.id
 	`, userId)
 	if err != nil {
 		return nil, err
@@ -178,12 +895,37 @@ func (s *Storage) GetPRsByReviewer(userId string) ([]models.PullRequestShort, er
 	return prs, nil
 }
 
+func (s *Storage) CountOpenReviews(userIds []string) (map[string]int, error) {
+	rows, err := s.conn().Query(`
+		SELECT ra.user_id, COUNT(*) AS count
+		FROM review_assignments ra
+		JOIN pull_requests pr ON pr.pull_request_id = ra.pull_request_id
+		WHERE ra.user_id = ANY($1) AND ra.unassigned_at IS NULL AND pr.status = 'OPEN'
+		GROUP BY ra.user_id
+	`, pq.Array(userIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int, len(userIds))
+	for rows.Next() {
+		var userId string
+		var count int
+		if err := rows.Scan(&userId, &count); err != nil {
+			return nil, err
+		}
+		counts[userId] = count
+	}
+	return counts, rows.Err()
+}
+
 func (s *Storage) GetAssignmentStats() ([]models.AssignmentStat, error) {
-	rows, err := s.DB.Query(`
-		SELECT elem AS user_id, COUNT(*) AS count
-		FROM pull_requests pr
-		CROSS JOIN jsonb_array_elements_text(pr.assigned_reviewers) AS elem
-		GROUP BY elem
+	rows, err := s.conn().Query(`
+		SELECT ra.user_id, COUNT(*) AS count
+		FROM review_assignments ra
+		WHERE ra.unassigned_at IS NULL
+		GROUP BY ra.user_id
 		ORDER BY count DESC
 	`)
 	if err != nil {
@@ -202,8 +944,100 @@ func (s *Storage) GetAssignmentStats() ([]models.AssignmentStat, error) {
 	return stats, nil
 }
 
+func (s *Storage) CountOpenPRsByTeam() (map[string]int, error) {
+	rows, err := s.conn().Query(`
+		SELECT t.team_name, COUNT(pr.pull_request_id)
+		FROM teams t
+		LEFT JOIN users u ON u.team_name = t.team_name
+		LEFT JOIN pull_requests pr ON pr.author_id = u.user_id AND pr.status = 'OPEN'
+		GROUP BY t.team_name
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	counts := make(map[string]int)
+	for rows.Next() {
+		var teamName string
+		var count int
+		if err := rows.Scan(&teamName, &count); err != nil {
+			return nil, err
+		}
+		counts[teamName] = count
+	}
+	return counts, rows.Err()
+}
+
+func (s *Storage) GetReviewerLoads() ([]models.ReviewerLoad, error) {
+	rows, err := s.conn().Query(`
+		SELECT u.user_id, u.team_name, COUNT(pr.pull_request_id), COALESCE(NULLIF(u.max_open_reviews, 0), t.max_open_reviews)
+		FROM users u
+		JOIN teams t ON t.team_name = u.team_name
+		LEFT JOIN review_assignments ra ON ra.user_id = u.user_id AND ra.unassigned_at IS NULL
+		LEFT JOIN pull_requests pr ON pr.pull_request_id = ra.pull_request_id AND pr.status = 'OPEN'
+		WHERE u.is_active
+		GROUP BY u.user_id, u.team_name, u.max_open_reviews, t.max_open_reviews
+		ORDER BY u.user_id
+	`)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var loads []models.ReviewerLoad
+	for rows.Next() {
+		var load models.ReviewerLoad
+		if err := rows.Scan(&load.UserId, &load.TeamName, &load.OpenReviews, &load.MaxOpenReviews); err != nil {
+			return nil, err
+		}
+		loads = append(loads, load)
+	}
+	return loads, rows.Err()
+}
+
 func (s *Storage) DeactivateTeam(teamName string) error {
-	result, err := s.DB.Exec("UPDATE users SET is_active = false WHERE team_name = $1", teamName)
+	result, err := s.conn().Exec("UPDATE users SET is_active = false WHERE team_name = $1", teamName)
+	if err != nil {
+		return err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rows == 0 {
+		return errs.New(errs.ErrNotFound, "team not found or no users to deactivate")
+	}
+	return nil
+}
+
+func (s *Storage) GetTeamSettings(teamName string) (models.TeamSettings, error) {
+	var settings models.TeamSettings
+	var strategy sql.NullString
+	var fallbackTeams pq.StringArray
+	var slaMinutes, reassignMinutes int
+	err := s.conn().QueryRow("SELECT team_name, reviewer_strategy, min_approvals, require_lead_approval, min_reviewers, max_reviewers, max_open_reviews, review_sla_minutes, reassign_after_minutes, fallback_teams, version FROM teams WHERE team_name = $1", teamName).
+		Scan(&settings.TeamName, &strategy, &settings.MinApprovals, &settings.RequireLeadApproval, &settings.MinReviewers, &settings.MaxReviewers, &settings.MaxOpenReviews,
+			&slaMinutes, &reassignMinutes, &fallbackTeams, &settings.Version)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return models.TeamSettings{}, errs.New(errs.ErrNotFound, "team not found")
+		}
+		return models.TeamSettings{}, err
+	}
+	settings.ReviewerStrategy = strategy.String
+	settings.ReviewSLA = time.Duration(slaMinutes) * time.Minute
+	settings.ReassignAfter = time.Duration(reassignMinutes) * time.Minute
+	if len(fallbackTeams) > 0 {
+		settings.FallbackTeams = fallbackTeams
+	}
+	return settings, nil
+}
+
+func (s *Storage) UpdateTeamSettings(settings models.TeamSettings) error {
+	result, err := s.conn().Exec("UPDATE teams SET reviewer_strategy = NULLIF($1, ''), min_approvals = $2, require_lead_approval = $3, min_reviewers = $4, max_reviewers = $5, max_open_reviews = $6, review_sla_minutes = $7, reassign_after_minutes = $8, fallback_teams = COALESCE($9::TEXT[], '{}'), version = version + 1 WHERE team_name = $10 AND version = $11",
+		settings.ReviewerStrategy, settings.MinApprovals, settings.RequireLeadApproval, settings.MinReviewers, settings.MaxReviewers, settings.MaxOpenReviews,
+		int(settings.ReviewSLA/time.Minute), int(settings.ReassignAfter/time.Minute), pq.StringArray(settings.FallbackTeams), settings.TeamName, settings.Version)
 	if err != nil {
 		return err
 	}
@@ -212,7 +1046,51 @@ func (s *Storage) DeactivateTeam(teamName string) error {
 		return err
 	}
 	if rows == 0 {
-		return errors.New("team not found or no users to deactivate")
+		return repository.ErrConflict
 	}
 	return nil
 }
+
+func (s *Storage) GetRoundRobinCursor(teamName string) (string, error) {
+	var cursor sql.NullString
+	// Блокируем строку команды до конца транзакции, чтобы параллельные выборы не получили одну позицию очереди
+	err := s.conn().QueryRow("SELECT round_robin_cursor FROM teams WHERE team_name = $1 FOR UPDATE", teamName).Scan(&cursor)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return "", errs.New(errs.ErrNotFound, "team not found")
+		}
+		return "", err
+	}
+	return cursor.String, nil
+}
+
+func (s *Storage) SetRoundRobinCursor(teamName, userId string) error {
+	_, err := s.conn().Exec("UPDATE teams SET round_robin_cursor = $1 WHERE team_name = $2", userId, teamName)
+	return err
+}
+
+const (
+	uniqueViolation     pq.ErrorCode = "23505"
+	foreignKeyViolation pq.ErrorCode = "23503"
+)
+
+// pqCode возвращает код ошибки Postgres или пустую строку для остальных ошибок
+func pqCode(err error) pq.ErrorCode {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		return pqErr.Code
+	}
+	return ""
+}
+
+// conflictError сводит ошибки сериализации и взаимоблокировки Postgres к repository.ErrConflict
+func conflictError(err error) error {
+	var pqErr *pq.Error
+	if errors.As(err, &pqErr) {
+		switch pqErr.Code {
+		case "40001", "40P01", "55P03":
+			return repository.ErrConflict
+		}
+	}
+	return err
+}
@@ -0,0 +1,565 @@
+package storage
+
+import (
+	"avito-internship/internal/errs"
+	"avito-internship/internal/models"
+	"avito-internship/internal/repository"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorage_AddTeam(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	teamName := "test-team"
+	members := []models.TeamMember{
+		{UserId: "1", Username: "user1", IsActive: true},
+		{UserId: "2", Username: "user2", IsActive: false},
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO teams").WithArgs(teamName).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO users").WithArgs("1", "user1", teamName, true).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO users").WithArgs("2", "user2", teamName, false).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	err = s.AddTeam(teamName, members)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_AddTeam_Duplicate(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO teams").WithArgs("team1").WillReturnError(&pq.Error{Code: "23505", Constraint: "teams_pkey"})
+	mock.ExpectRollback()
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO teams").WithArgs("team2").WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO users").WithArgs("1", "user1", "team2", true).WillReturnError(&pq.Error{Code: "23505", Constraint: "users_pkey"})
+	mock.ExpectRollback()
+
+	err = s.AddTeam("team1", nil)
+	assert.ErrorIs(t, err, errs.ErrTeamExists)
+	assert.EqualError(t, err, "team already exists")
+
+	err = s.AddTeam("team2", []models.TeamMember{{UserId: "1", Username: "user1", IsActive: true}})
+	assert.ErrorIs(t, err, errs.ErrAlreadyExists)
+	assert.NotErrorIs(t, err, errs.ErrTeamExists)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_GetTeam(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	teamName := "test-team"
+	expectedMembers := []models.TeamMember{
+		{UserId: "1", Username: "user1", IsActive: true},
+		{UserId: "2", Username: "user2", IsActive: false},
+	}
+
+	rows := sqlmock.NewRows([]string{"user_id", "username", "is_active"}).
+		AddRow("1", "user1", true).
+		AddRow("2", "user2", false)
+	mock.ExpectQuery("SELECT user_id, username, is_active FROM users WHERE team_name = \\$1").WithArgs(teamName).WillReturnRows(rows)
+
+	members, err := s.GetTeam(teamName)
+	assert.NoError(t, err)
+	assert.Equal(t, expectedMembers, members)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_CreatePR(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	now := time.Now()
+	pr := models.PullRequest{
+		PullRequestId:     "pr1",
+		PullRequestName:   "Test PR",
+		AuthorId:          "author1",
+		Status:            "OPEN",
+		AssignedReviewers: []string{"rev1", "rev2"},
+		CreatedAt:         &now,
+	}
+
+	mock.ExpectBegin()
+	mock.ExpectExec("INSERT INTO pull_requests").
+		WithArgs("pr1", "Test PR", "author1", "OPEN", &now, false).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO review_assignments").
+		WithArgs("pr1", "rev1", models.RoleReviewer, &now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO review_assignments").
+		WithArgs("pr1", "rev2", models.RoleReviewer, &now).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	err = s.CreatePR(pr)
+	assert.NoError(t, err)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_GetPR(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	prId := "pr1"
+	createdAt := time.Now()
+
+	prRows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status", "created_at", "merged_at", "force_merged", "under_reviewed", "version", "reviewers"}).
+		AddRow(prId, "Test PR", "author1", "OPEN", createdAt, nil, false, true, 2, "{rev1,rev2}")
+	mock.ExpectQuery("SELECT pr.pull_request_id, .* FROM pull_requests pr LEFT JOIN review_assignments ra ON ra.pull_request_id = pr.pull_request_id AND ra.unassigned_at IS NULL WHERE pr.pull_request_id = \\$1").
+		WithArgs(prId).WillReturnRows(prRows)
+
+	pr, err := s.GetPR(prId)
+	assert.NoError(t, err)
+	assert.Equal(t, "OPEN", pr.Status)
+	assert.Equal(t, []string{"rev1", "rev2"}, pr.AssignedReviewers)
+	assert.Nil(t, pr.MergedAt)
+	assert.True(t, pr.UnderReviewed)
+	assert.Equal(t, 2, pr.Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_UpdatePR(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	now := time.Now()
+	pr := models.PullRequest{
+		PullRequestId:     "pr1",
+		PullRequestName:   "Test PR",
+		AuthorId:          "author1",
+		Status:            "MERGED",
+		AssignedReviewers: []string{"rev1", "rev2"},
+		MergedAt:          &now,
+		ForceMerged:       true,
+		Version:           3,
+	}
+
+	mock.ExpectExec("UPDATE pull_requests SET pull_request_name = \\$1, author_id = \\$2, status = \\$3, merged_at = \\$4, force_merged = \\$5, version = version \\+ 1 WHERE pull_request_id = \\$6 AND version = \\$7").
+		WithArgs("Test PR", "author1", "MERGED", &now, true, "pr1", 3).WillReturnResult(sqlmock.NewResult(1, 1))
+	// Версия уже изменена другим запросом
+	mock.ExpectExec("UPDATE pull_requests SET").
+		WithArgs("Test PR", "author1", "MERGED", &now, true, "pr1", 3).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.NoError(t, s.UpdatePR(pr))
+	assert.ErrorIs(t, s.UpdatePR(pr), repository.ErrConflict)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_UpdateTeamSettings(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	settings := models.TeamSettings{TeamName: "team1", MinApprovals: 1, MaxReviewers: 2, FallbackTeams: []string{"team2"}, Version: 4}
+	mock.ExpectExec("UPDATE teams SET .*, version = version \\+ 1 WHERE team_name = \\$10 AND version = \\$11").
+		WithArgs("", 1, false, 0, 2, 0, 0, 0, sqlmock.AnyArg(), "team1", 4).WillReturnResult(sqlmock.NewResult(1, 1))
+	// Настройки уже изменены другим запросом
+	mock.ExpectExec("UPDATE teams SET").
+		WithArgs("", 1, false, 0, 2, 0, 0, 0, sqlmock.AnyArg(), "team1", 4).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.NoError(t, s.UpdateTeamSettings(settings))
+	assert.ErrorIs(t, s.UpdateTeamSettings(settings), repository.ErrConflict)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_ReassignReviewer(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	now := time.Now()
+	mock.ExpectExec("UPDATE review_assignments SET unassigned_at = \\$1 WHERE pull_request_id = \\$2 AND user_id = \\$3 AND unassigned_at IS NULL").
+		WithArgs(now, "pr1", "rev1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("INSERT INTO review_assignments").
+		WithArgs("pr1", "rev3", models.RoleReviewer, now).WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectExec("UPDATE review_assignments SET unassigned_at").
+		WithArgs(now, "pr1", "rev1").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, s.UnassignReviewer("pr1", "rev1", now))
+	require.NoError(t, s.AssignReviewer(models.Assignment{PullRequestId: "pr1", UserId: "rev3", Role: models.RoleReviewer, AssignedAt: now}))
+	assert.EqualError(t, s.UnassignReviewer("pr1", "rev1", now), "reviewer is not assigned to this PR")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_GetPRsByReviewer(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	rows := sqlmock.NewRows([]string{"pull_request_id", "pull_request_name", "author_id", "status"}).
+		AddRow("pr1", "Test PR", "author1", "OPEN")
+	mock.ExpectQuery("FROM review_assignments ra JOIN pull_requests pr ON pr.pull_request_id = ra.pull_request_id WHERE ra.user_id = \\$1 AND ra.unassigned_at IS NULL").
+		WithArgs("rev1").WillReturnRows(rows)
+
+	prs, err := s.GetPRsByReviewer("rev1")
+	assert.NoError(t, err)
+	assert.Equal(t, []models.PullRequestShort{{PullRequestId: "pr1", PullRequestName: "Test PR", AuthorId: "author1", Status: "OPEN"}}, prs)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_DeactivateTeam(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	teamName := "test-team"
+
+	mock.ExpectExec("UPDATE users SET is_active = false WHERE team_name = \\$1").WithArgs(teamName).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec("UPDATE users SET is_active = false WHERE team_name = \\$1").WithArgs("unknown").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	assert.NoError(t, s.DeactivateTeam(teamName))
+	assert.EqualError(t, s.DeactivateTeam("unknown"), "team not found or no users to deactivate")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_GetAssignmentStats(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	expectedStats := []models.AssignmentStat{
+		{UserId: "user1", Count: 5},
+		{UserId: "user2", Count: 3},
+	}
+
+	rows := sqlmock.NewRows([]string{"user_id", "count"}).
+		AddRow("user1", 5).
+		AddRow("user2", 3)
+	mock.ExpectQuery("SELECT ra.user_id, COUNT\\(\\*\\) AS count FROM review_assignments ra WHERE ra.unassigned_at IS NULL GROUP BY ra.user_id ORDER BY count DESC").WillReturnRows(rows)
+
+	stats, err := s.GetAssignmentStats()
+	assert.NoError(t, err)
+	assert.Equal(t, expectedStats, stats)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_GetReviewerLoads(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	rows := sqlmock.NewRows([]string{"user_id", "team_name", "count", "max_open_reviews"}).
+		AddRow("u1", "backend", 0, 5).
+		AddRow("u2", "backend", 3, 0)
+	mock.ExpectQuery("SELECT u.user_id, u.team_name, COUNT\\(pr.pull_request_id\\), COALESCE\\(NULLIF\\(u.max_open_reviews, 0\\), t.max_open_reviews\\) FROM users u JOIN teams t .* WHERE u.is_active").WillReturnRows(rows)
+
+	loads, err := s.GetReviewerLoads()
+	assert.NoError(t, err)
+	assert.Equal(t, []models.ReviewerLoad{
+		{UserId: "u1", TeamName: "backend", OpenReviews: 0, MaxOpenReviews: 5},
+		{UserId: "u2", TeamName: "backend", OpenReviews: 3},
+	}, loads)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_Reviews(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	now := time.Now()
+	review := models.Review{PullRequestId: "pr1", ReviewerId: "rev1", State: models.ReviewApproved, SubmittedAt: now}
+	mock.ExpectExec("INSERT INTO reviews").
+		WithArgs("pr1", "rev1", models.ReviewApproved, "", now).WillReturnResult(sqlmock.NewResult(1, 1))
+	rows := sqlmock.NewRows([]string{"pull_request_id", "reviewer_id", "state", "body", "submitted_at"}).
+		AddRow("pr1", "rev1", models.ReviewApproved, "", now)
+	mock.ExpectQuery("SELECT pull_request_id, reviewer_id, state, body, submitted_at FROM reviews WHERE pull_request_id = \\$1 ORDER BY submitted_at, id").
+		WithArgs("pr1").WillReturnRows(rows)
+
+	require.NoError(t, s.AddReview(review))
+	reviews, err := s.GetReviews("pr1")
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Review{review}, reviews)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_UnansweredAssignments(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	assignedAt := time.Now().Add(-time.Hour)
+	rows := sqlmock.NewRows([]string{"pull_request_id", "user_id", "role", "assigned_at", "overdue_at", "reassign_failed_at"}).
+		AddRow("pr1", "rev1", models.RoleReviewer, assignedAt, nil, nil)
+	mock.ExpectQuery("FROM review_assignments ra JOIN pull_requests pr .* WHERE ra.unassigned_at IS NULL AND ra.role = \\$1 AND NOT EXISTS").
+		WithArgs(models.RoleReviewer).WillReturnRows(rows)
+	now := time.Now()
+	mock.ExpectExec("UPDATE review_assignments SET overdue_at = \\$1 WHERE pull_request_id = \\$2 AND user_id = \\$3 AND unassigned_at IS NULL").
+		WithArgs(now, "pr1", "rev1").WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec("UPDATE review_assignments SET overdue_at").
+		WithArgs(now, "pr1", "rev2").WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec("UPDATE review_assignments SET reassign_failed_at = \\$1 WHERE pull_request_id = \\$2 AND user_id = \\$3 AND unassigned_at IS NULL").
+		WithArgs(now, "pr1", "rev1").WillReturnResult(sqlmock.NewResult(0, 1))
+
+	assignments, err := s.GetUnansweredAssignments()
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Assignment{{PullRequestId: "pr1", UserId: "rev1", Role: models.RoleReviewer, AssignedAt: assignedAt}}, assignments)
+	assert.NoError(t, s.MarkAssignmentOverdue("pr1", "rev1", now))
+	assert.ErrorIs(t, s.MarkAssignmentOverdue("pr1", "rev2", now), errs.ErrNotAssigned)
+	assert.NoError(t, s.MarkReassignFailed("pr1", "rev1", now))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_Webhooks(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	now := time.Now()
+	mock.ExpectQuery("INSERT INTO webhooks \\(url, events, secret, created_at\\) VALUES \\(\\$1, \\$2, \\$3, \\$4\\) RETURNING id").
+		WithArgs("https://bot", pq.StringArray{models.EventPRCreated}, "s3cret", now).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	mock.ExpectQuery("INSERT INTO webhook_deliveries").
+		WithArgs(int64(2), "ev1", models.EventPRCreated, []byte(`{}`), models.DeliveryPending, now, now).
+		WillReturnError(&pq.Error{Code: "23503"})
+	rows := sqlmock.NewRows([]string{"id", "webhook_id", "event_id", "event", "payload", "status", "attempts", "next_attempt_at",
+		"last_error", "response_status", "created_at", "delivered_at"}).
+		AddRow(5, 1, "ev1", models.EventPRCreated, []byte(`{}`), models.DeliveryPending, 1, now, "timeout", 0, now, nil)
+	mock.ExpectQuery("FROM webhook_deliveries WHERE status = \\$1 AND next_attempt_at <= \\$2 ORDER BY next_attempt_at, id LIMIT \\$3").
+		WithArgs(models.DeliveryPending, now, 10).WillReturnRows(rows)
+
+	webhook, err := s.CreateWebhook(models.WebhookSubscription{URL: "https://bot", Events: []string{models.EventPRCreated}, Secret: "s3cret", CreatedAt: now})
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), webhook.Id)
+	_, err = s.AddDelivery(models.WebhookDelivery{WebhookId: 2, EventId: "ev1", Event: models.EventPRCreated, Payload: []byte(`{}`),
+		Status: models.DeliveryPending, NextAttemptAt: now, CreatedAt: now})
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	due, err := s.GetDueDeliveries(now, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []models.WebhookDelivery{{Id: 5, WebhookId: 1, EventId: "ev1", Event: models.EventPRCreated, Payload: []byte(`{}`),
+		Status: models.DeliveryPending, Attempts: 1, NextAttemptAt: now, LastError: "timeout", CreatedAt: now}}, due)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_CodeOwnerRules(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	mock.ExpectExec("INSERT INTO code_owner_rules .* ON CONFLICT \\(pattern\\) DO UPDATE").
+		WithArgs("/payments/", pq.StringArray(nil), pq.StringArray{"payments"}).WillReturnResult(sqlmock.NewResult(1, 1))
+	rows := sqlmock.NewRows([]string{"pattern", "users", "teams"}).
+		AddRow("/payments/", "{}", "{payments}")
+	mock.ExpectQuery("SELECT pattern, users, teams FROM code_owner_rules ORDER BY id").WillReturnRows(rows)
+	mock.ExpectExec("DELETE FROM code_owner_rules WHERE pattern = \\$1").
+		WithArgs("*.sql").WillReturnResult(sqlmock.NewResult(0, 0))
+
+	require.NoError(t, s.SaveCodeOwnerRule(models.CodeOwnerRule{Pattern: "/payments/", Teams: []string{"payments"}}))
+	rules, err := s.GetCodeOwnerRules()
+	assert.NoError(t, err)
+	assert.Equal(t, []models.CodeOwnerRule{{Pattern: "/payments/", Users: []string{}, Teams: []string{"payments"}}}, rules)
+	assert.ErrorIs(t, s.DeleteCodeOwnerRule("*.sql"), errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_Absences(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	now := time.Now()
+	absence := models.Absence{UserId: "u1", Kind: models.AbsenceVacation, StartsAt: now, EndsAt: now.Add(time.Hour)}
+	mock.ExpectQuery("INSERT INTO user_absences \\(user_id, kind, starts_at, ends_at\\) VALUES \\(\\$1, \\$2, \\$3, \\$4\\) RETURNING id").
+		WithArgs("u1", models.AbsenceVacation, now, now.Add(time.Hour)).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(7))
+	rows := sqlmock.NewRows([]string{"id", "user_id", "kind", "starts_at", "ends_at", "processed"}).
+		AddRow(7, "u1", models.AbsenceVacation, now, now.Add(time.Hour), false)
+	mock.ExpectQuery("SELECT .* FROM user_absences WHERE starts_at <= \\$1 AND ends_at > \\$1").
+		WithArgs(now).WillReturnRows(rows)
+
+	created, err := s.AddAbsence(absence)
+	require.NoError(t, err)
+	assert.Equal(t, int64(7), created.Id)
+	absences, err := s.GetAbsencesAt(now)
+	assert.NoError(t, err)
+	assert.Equal(t, []models.Absence{created}, absences)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_ForgeIdentities(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	mock.ExpectExec("INSERT INTO forge_identities .* ON CONFLICT \\(forge, username\\) DO UPDATE").
+		WithArgs("github", "ghost", "u404", int64(0)).
+		WillReturnError(&pq.Error{Code: "23503"})
+	mock.ExpectQuery("SELECT user_id, COALESCE\\(account_id, 0\\) FROM forge_identities WHERE forge = \\$1 AND username = \\$2").
+		WithArgs("gitlab", "alice").
+		WillReturnRows(sqlmock.NewRows([]string{"user_id", "account_id"}).AddRow("u1", 17))
+	mock.ExpectQuery("SELECT username, user_id FROM forge_identities WHERE forge = \\$1 AND account_id = \\$2").
+		WithArgs("gitlab", int64(17)).
+		WillReturnRows(sqlmock.NewRows([]string{"username", "user_id"}).AddRow("alice", "u1"))
+	mock.ExpectQuery("SELECT username, user_id FROM forge_identities WHERE forge = \\$1 AND account_id = \\$2").
+		WithArgs("gitlab", int64(23)).
+		WillReturnRows(sqlmock.NewRows([]string{"username", "user_id"}))
+	mock.ExpectExec("DELETE FROM forge_identities WHERE forge = \\$1 AND username = \\$2").
+		WithArgs("gitlab", "bob").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+
+	err = s.SetForgeIdentity(models.ForgeIdentity{Forge: "github", Username: "ghost", UserId: "u404"})
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	identity, err := s.GetForgeIdentity("gitlab", "alice")
+	require.NoError(t, err)
+	assert.Equal(t, models.ForgeIdentity{Forge: "gitlab", Username: "alice", UserId: "u1", AccountId: 17}, identity)
+	identity, err = s.GetForgeIdentityByAccount("gitlab", 17)
+	require.NoError(t, err)
+	assert.Equal(t, models.ForgeIdentity{Forge: "gitlab", Username: "alice", UserId: "u1", AccountId: 17}, identity)
+	_, err = s.GetForgeIdentityByAccount("gitlab", 23)
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	assert.ErrorIs(t, s.DeleteForgeIdentity("gitlab", "bob"), errs.ErrNotFound)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_AuditLog(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	now := time.Now()
+	entry := models.AuditEntry{
+		OccurredAt:    now,
+		Actor:         "alice",
+		Action:        models.AuditPRReviewerReassigned,
+		TargetType:    models.AuditTargetPullRequest,
+		TargetId:      "pr1",
+		PullRequestId: "pr1",
+		UserIds:       []string{"author", "u1", "u2"},
+		TeamName:      "backend",
+		Reason:        models.AuditReasonDeactivation,
+		Before:        []byte(`{"assigned_reviewers":["u1"]}`),
+	}
+	mock.ExpectExec("INSERT INTO audit_log").
+		WithArgs(now, "alice", models.AuditPRReviewerReassigned, models.AuditTargetPullRequest, "pr1", "pr1",
+			pq.StringArray{"author", "u1", "u2"}, "backend", models.AuditReasonDeactivation, "", entry.Before, nil).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	rows := sqlmock.NewRows([]string{"id", "occurred_at", "actor", "action", "target_type", "target_id", "pull_request_id", "user_ids",
+		"team_name", "reason", "error", "state_before", "state_after"}).
+		AddRow(1, now, "alice", models.AuditPRReviewerReassigned, models.AuditTargetPullRequest, "pr1", "pr1", "{author,u1,u2}",
+			"backend", models.AuditReasonDeactivation, "", entry.Before, nil)
+	mock.ExpectQuery("FROM audit_log .* ORDER BY id DESC LIMIT \\$6").
+		WithArgs("", "u1", "", sql.NullTime{Time: now, Valid: true}, sql.NullTime{}, sql.NullInt64{Int64: 10, Valid: true}).
+		WillReturnRows(rows)
+
+	require.NoError(t, s.AddAuditEntry(entry))
+	entries, err := s.GetAuditEntries(models.AuditFilter{UserId: "u1", From: now, Limit: 10})
+	require.NoError(t, err)
+	entry.Id = 1
+	assert.Equal(t, []models.AuditEntry{entry}, entries)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_PREvents(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	now := time.Now()
+	mock.ExpectExec("INSERT INTO pull_request_events").
+		WithArgs("pr404", models.PREventMerged, now, "system", "", "", "", "", true).
+		WillReturnError(&pq.Error{Code: "23503"})
+	rows := sqlmock.NewRows([]string{"pull_request_id", "type", "occurred_at", "actor", "user_id", "reason", "pull_request_name", "review_state", "force_merged"}).
+		AddRow("pr1", models.PREventCreated, now, "alice", "u1", "", "Feature", "", false).
+		AddRow("pr1", models.PREventReviewerAssigned, now, "alice", "u2", models.AuditReasonManual, "", "", false)
+	mock.ExpectQuery("FROM pull_request_events WHERE pull_request_id = \\$1 ORDER BY id").
+		WithArgs("pr1").WillReturnRows(rows)
+
+	err = s.AddPREvent(models.PullRequestEvent{PullRequestId: "pr404", Type: models.PREventMerged, OccurredAt: now, Actor: "system", ForceMerged: true})
+	assert.ErrorIs(t, err, errs.ErrNotFound)
+	events, err := s.GetPREvents("pr1")
+	require.NoError(t, err)
+	assert.Equal(t, []models.PullRequestEvent{
+		{PullRequestId: "pr1", Type: models.PREventCreated, OccurredAt: now, Actor: "alice", UserId: "u1", PullRequestName: "Feature"},
+		{PullRequestId: "pr1", Type: models.PREventReviewerAssigned, OccurredAt: now, Actor: "alice", UserId: "u2", Reason: models.AuditReasonManual},
+	}, events)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestStorage_Outbox(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	require.NoError(t, err)
+	defer db.Close()
+
+	s := &Storage{DB: db}
+
+	now := time.Now()
+	event := models.OutboxEvent{EventId: "ev1", Event: models.EventPRCreated, Payload: []byte(`{"id":"ev1"}`), OccurredAt: now}
+	mock.ExpectExec("INSERT INTO outbox").
+		WithArgs("ev1", models.EventPRCreated, event.Payload, now).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	rows := sqlmock.NewRows([]string{"id", "event_id", "event", "payload", "occurred_at"}).
+		AddRow(1, "ev1", models.EventPRCreated, event.Payload, now)
+	mock.ExpectQuery("FROM outbox o\\s+WHERE NOT EXISTS .* ORDER BY o.id LIMIT \\$2").
+		WithArgs("file", 100).
+		WillReturnRows(rows)
+	mock.ExpectExec("INSERT INTO outbox_published .* ON CONFLICT DO NOTHING").
+		WithArgs(pq.Int64Array{1}, "file").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+
+	require.NoError(t, s.AddOutboxEvent(event))
+	events, err := s.GetUnpublishedOutboxEvents("file", 100)
+	require.NoError(t, err)
+	event.Id = 1
+	assert.Equal(t, []models.OutboxEvent{event}, events)
+	require.NoError(t, s.MarkOutboxPublished("file", []int64{1}))
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,93 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	HeaderEvent     = "X-Reviewer-Event"
+	HeaderEventId   = "X-Reviewer-Event-Id"
+	HeaderDelivery  = "X-Reviewer-Delivery"
+	HeaderSignature = "X-Reviewer-Signature"
+
+	// MaxAttempts - после стольких неудачных попыток доставка помечается FAILED
+	MaxAttempts = 8
+
+	baseBackoff = 30 * time.Second
+	maxBackoff  = 6 * time.Hour
+)
+
+// Sign возвращает подпись тела запроса в формате "sha256=<hex HMAC-SHA256>"
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify сравнивает подпись за постоянное время
+func Verify(secret string, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(signature))
+}
+
+// Backoff возвращает паузу перед попыткой attempt+1 после attempt неудачных: 30s, 1m, 2m, ... не больше 6h
+func Backoff(attempt int) time.Duration {
+	if attempt < 1 {
+		attempt = 1
+	}
+	d := baseBackoff
+	for i := 1; i < attempt && d < maxBackoff; i++ {
+		d *= 2
+	}
+	return min(d, maxBackoff)
+}
+
+// Request - одна попытка доставки события
+type Request struct {
+	URL        string
+	Secret     string
+	Event      string
+	EventId    string
+	DeliveryId int64
+	Payload    []byte
+}
+
+type Sender struct {
+	client *http.Client
+}
+
+func NewSender(client *http.Client) *Sender {
+	return &Sender{client: client}
+}
+
+// Send отправляет подписанный POST и возвращает HTTP-статус ответа.
+// Любой ответ вне 2xx считается ошибкой, статус при этом всё равно возвращается.
+func (s *Sender) Send(ctx context.Context, req Request) (int, error) {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, req.URL, bytes.NewReader(req.Payload))
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	httpReq.Header.Set(HeaderEvent, req.Event)
+	httpReq.Header.Set(HeaderEventId, req.EventId)
+	httpReq.Header.Set(HeaderDelivery, strconv.FormatInt(req.DeliveryId, 10))
+	httpReq.Header.Set(HeaderSignature, Sign(req.Secret, req.Payload))
+	resp, err := s.client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
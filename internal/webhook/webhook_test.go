@@ -0,0 +1,57 @@
+package webhook
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSign(t *testing.T) {
+	assert.Equal(t, "sha256=77325902caca812dc259733aacd046b73817372c777b8d95b402647474516e13", Sign("secret", []byte(`{}`)))
+	signature := Sign("secret", []byte(`{"event":"pr.created"}`))
+	assert.True(t, Verify("secret", []byte(`{"event":"pr.created"}`), signature))
+	assert.False(t, Verify("other", []byte(`{"event":"pr.created"}`), signature))
+	assert.False(t, Verify("secret", []byte(`{"event":"pr.merged"}`), signature))
+}
+
+func TestBackoff(t *testing.T) {
+	assert.Equal(t, 30*time.Second, Backoff(0))
+	assert.Equal(t, 30*time.Second, Backoff(1))
+	assert.Equal(t, time.Minute, Backoff(2))
+	assert.Equal(t, 4*time.Minute, Backoff(4))
+	assert.Equal(t, 6*time.Hour, Backoff(20))
+}
+
+func TestSender_Send(t *testing.T) {
+	var gotBody []byte
+	var gotHeader http.Header
+	status := http.StatusNoContent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotBody, _ = io.ReadAll(r.Body)
+		gotHeader = r.Header
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	sender := NewSender(srv.Client())
+	req := Request{URL: srv.URL, Secret: "s3cret", Event: "pr.created", EventId: "ev1", DeliveryId: 7, Payload: []byte(`{"id":"ev1"}`)}
+	code, err := sender.Send(context.Background(), req)
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNoContent, code)
+	assert.Equal(t, `{"id":"ev1"}`, string(gotBody))
+	assert.Equal(t, "pr.created", gotHeader.Get(HeaderEvent))
+	assert.Equal(t, "ev1", gotHeader.Get(HeaderEventId))
+	assert.Equal(t, "7", gotHeader.Get(HeaderDelivery))
+	assert.True(t, Verify("s3cret", gotBody, gotHeader.Get(HeaderSignature)))
+
+	status = http.StatusBadGateway
+	code, err = sender.Send(context.Background(), req)
+	assert.Error(t, err)
+	assert.Equal(t, http.StatusBadGateway, code)
+}
@@ -0,0 +1,30 @@
+package worker
+
+import (
+	"context"
+	"time"
+)
+
+// Logger - подмножество echo.Logger, которым пользуются фоновые задачи
+type Logger interface {
+	Errorf(format string, args ...interface{})
+}
+
+// Run вызывает fn раз в interval, пока ctx не отменён. Ошибка задачи пишется в лог и не останавливает следующие запуски.
+func Run(ctx context.Context, name string, interval time.Duration, logger Logger, fn func(ctx context.Context) error) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if ctx.Err() != nil {
+				return
+			}
+			if err := fn(ctx); err != nil {
+				logger.Errorf("%s: %v", name, err)
+			}
+		}
+	}
+}
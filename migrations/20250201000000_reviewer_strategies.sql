@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS reviewer_strategy VARCHAR(50);
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS round_robin_cursor VARCHAR(255);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS review_weight INTEGER NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN IF EXISTS review_weight;
+ALTER TABLE teams DROP COLUMN IF EXISTS round_robin_cursor;
+ALTER TABLE teams DROP COLUMN IF EXISTS reviewer_strategy;
@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS review_assignments (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id),
+    role VARCHAR(50) NOT NULL DEFAULT 'REVIEWER',
+    assigned_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    unassigned_at TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS review_assignments_active_uniq
+    ON review_assignments (pull_request_id, user_id) WHERE unassigned_at IS NULL;
+CREATE INDEX IF NOT EXISTS review_assignments_user_active_idx
+    ON review_assignments (user_id) WHERE unassigned_at IS NULL;
+
+-- Переносим назначения из JSONB, сохраняя порядок ревьюверов в массиве
+INSERT INTO review_assignments (pull_request_id, user_id, role, assigned_at)
+SELECT pr.pull_request_id, elem.user_id, 'REVIEWER', COALESCE(pr.created_at, CURRENT_TIMESTAMP)
+FROM pull_requests pr
+CROSS JOIN LATERAL jsonb_array_elements_text(pr.assigned_reviewers) WITH ORDINALITY AS elem(user_id, ord)
+JOIN users u ON u.user_id = elem.user_id
+WHERE jsonb_typeof(pr.assigned_reviewers) = 'array'
+ORDER BY pr.pull_request_id, elem.ord;
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS assigned_reviewers;
+
+-- +goose Down
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS assigned_reviewers JSONB;
+
+UPDATE pull_requests pr
+SET assigned_reviewers = COALESCE((
+    SELECT jsonb_agg(ra.user_id ORDER BY ra.assigned_at, ra.id)
+    FROM review_assignments ra
+    WHERE ra.pull_request_id = pr.pull_request_id AND ra.unassigned_at IS NULL
+), '[]'::jsonb);
+
+DROP TABLE IF EXISTS review_assignments;
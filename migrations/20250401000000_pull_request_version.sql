@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS version;
@@ -0,0 +1,11 @@
+-- +goose Up
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS min_approvals INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS require_lead_approval BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS is_lead BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS force_merged BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS force_merged;
+ALTER TABLE users DROP COLUMN IF EXISTS is_lead;
+ALTER TABLE teams DROP COLUMN IF EXISTS require_lead_approval;
+ALTER TABLE teams DROP COLUMN IF EXISTS min_approvals;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS min_reviewers INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS max_reviewers INTEGER NOT NULL DEFAULT 2;
+ALTER TABLE pull_requests ADD COLUMN IF NOT EXISTS under_reviewed BOOLEAN NOT NULL DEFAULT FALSE;
+
+-- +goose Down
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS under_reviewed;
+ALTER TABLE teams DROP COLUMN IF EXISTS max_reviewers;
+ALTER TABLE teams DROP COLUMN IF EXISTS min_reviewers;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS fallback_teams TEXT[] NOT NULL DEFAULT '{}';
+
+-- +goose Down
+ALTER TABLE teams DROP COLUMN IF EXISTS fallback_teams;
@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS code_owner_rules (
+    id BIGSERIAL PRIMARY KEY,
+    pattern TEXT NOT NULL UNIQUE,
+    users TEXT[] NOT NULL DEFAULT '{}',
+    teams TEXT[] NOT NULL DEFAULT '{}'
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS code_owner_rules;
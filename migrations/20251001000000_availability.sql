@@ -0,0 +1,24 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS user_absences (
+    id BIGSERIAL PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id),
+    kind VARCHAR(32) NOT NULL CHECK (kind IN ('VACATION', 'SICK_LEAVE', 'OTHER')),
+    starts_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL CHECK (ends_at > starts_at),
+    -- Открытые ревью пользователя уже переназначены фоновой обработкой
+    processed BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS user_absences_period_idx ON user_absences (starts_at, ends_at);
+
+CREATE TABLE IF NOT EXISTS user_working_hours (
+    user_id VARCHAR(255) PRIMARY KEY REFERENCES users(user_id),
+    timezone TEXT NOT NULL,
+    weekdays INTEGER[] NOT NULL,
+    start_time VARCHAR(5) NOT NULL,
+    end_time VARCHAR(5) NOT NULL
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS user_working_hours;
+DROP TABLE IF EXISTS user_absences;
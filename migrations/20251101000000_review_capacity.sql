@@ -0,0 +1,8 @@
+-- +goose Up
+-- 0 в teams означает отсутствие ограничения, 0 в users - ограничение команды
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER NOT NULL DEFAULT 0 CHECK (max_open_reviews >= 0);
+ALTER TABLE users ADD COLUMN IF NOT EXISTS max_open_reviews INTEGER NOT NULL DEFAULT 0 CHECK (max_open_reviews >= 0);
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN IF EXISTS max_open_reviews;
+ALTER TABLE teams DROP COLUMN IF EXISTS max_open_reviews;
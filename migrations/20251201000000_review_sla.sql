@@ -0,0 +1,10 @@
+-- +goose Up
+-- 0 означает, что SLA (или автоматическое переназначение) для команды не действует
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS review_sla_minutes INTEGER NOT NULL DEFAULT 0 CHECK (review_sla_minutes >= 0);
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS reassign_after_minutes INTEGER NOT NULL DEFAULT 0 CHECK (reassign_after_minutes >= 0);
+ALTER TABLE review_assignments ADD COLUMN IF NOT EXISTS overdue_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE review_assignments DROP COLUMN IF EXISTS overdue_at;
+ALTER TABLE teams DROP COLUMN IF EXISTS reassign_after_minutes;
+ALTER TABLE teams DROP COLUMN IF EXISTS review_sla_minutes;
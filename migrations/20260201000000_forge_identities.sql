@@ -0,0 +1,10 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS forge_identities (
+    forge VARCHAR(16) NOT NULL CHECK (forge IN ('github', 'gitlab')),
+    username VARCHAR(255) NOT NULL,
+    user_id VARCHAR(255) NOT NULL REFERENCES users(user_id) ON DELETE CASCADE,
+    PRIMARY KEY (forge, username)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS forge_identities;
@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS audit_log (
+    id BIGSERIAL PRIMARY KEY,
+    occurred_at TIMESTAMPTZ NOT NULL,
+    actor TEXT NOT NULL,
+    action VARCHAR(64) NOT NULL,
+    target_type VARCHAR(32) NOT NULL,
+    target_id TEXT NOT NULL,
+    pull_request_id VARCHAR(255) NOT NULL DEFAULT '',
+    user_ids TEXT[] NOT NULL DEFAULT '{}',
+    team_name VARCHAR(255) NOT NULL DEFAULT '',
+    reason VARCHAR(32) NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    state_before JSONB,
+    state_after JSONB
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_occurred_idx ON audit_log (occurred_at);
+CREATE INDEX IF NOT EXISTS audit_log_pull_request_idx ON audit_log (pull_request_id, id) WHERE pull_request_id <> '';
+CREATE INDEX IF NOT EXISTS audit_log_team_idx ON audit_log (team_name, id) WHERE team_name <> '';
+CREATE INDEX IF NOT EXISTS audit_log_user_ids_idx ON audit_log USING GIN (user_ids);
+
+-- Журнал только дополняется: изменение и удаление записей запрещены
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION audit_log_append_only() RETURNS trigger AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_log is append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+CREATE TRIGGER audit_log_append_only BEFORE UPDATE OR DELETE ON audit_log
+    FOR EACH ROW EXECUTE FUNCTION audit_log_append_only();
+
+-- +goose Down
+DROP TABLE IF EXISTS audit_log;
+DROP FUNCTION IF EXISTS audit_log_append_only();
@@ -0,0 +1,40 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS pull_request_events (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id VARCHAR(255) NOT NULL REFERENCES pull_requests(pull_request_id) ON DELETE CASCADE,
+    type VARCHAR(32) NOT NULL CHECK (type IN ('created', 'reviewer_assigned', 'reviewer_unassigned', 'review_submitted', 'merged')),
+    occurred_at TIMESTAMP NOT NULL,
+    actor TEXT NOT NULL,
+    user_id VARCHAR(255) NOT NULL DEFAULT '',
+    reason VARCHAR(32) NOT NULL DEFAULT '',
+    pull_request_name VARCHAR(255) NOT NULL DEFAULT '',
+    review_state VARCHAR(32) NOT NULL DEFAULT '',
+    force_merged BOOLEAN NOT NULL DEFAULT FALSE
+);
+
+CREATE INDEX IF NOT EXISTS pull_request_events_pr_idx ON pull_request_events (pull_request_id, id);
+
+-- Восстанавливаем историю существующих PR из назначений и ревью; причина прошлых замен неизвестна
+INSERT INTO pull_request_events (pull_request_id, type, occurred_at, actor, user_id, reason, pull_request_name, review_state, force_merged)
+SELECT pull_request_id, type, occurred_at, 'system', user_id, '', pull_request_name, review_state, force_merged
+FROM (
+    SELECT pull_request_id, 'created' AS type, COALESCE(created_at, CURRENT_TIMESTAMP) AS occurred_at, author_id AS user_id,
+        pull_request_name, '' AS review_state, FALSE AS force_merged, 0 AS ord, 0::bigint AS source_id
+    FROM pull_requests
+    UNION ALL
+    SELECT pull_request_id, 'reviewer_unassigned', unassigned_at, user_id, '', '', FALSE, 1, id
+    FROM review_assignments WHERE unassigned_at IS NOT NULL
+    UNION ALL
+    SELECT pull_request_id, 'reviewer_assigned', assigned_at, user_id, '', '', FALSE, 2, id
+    FROM review_assignments
+    UNION ALL
+    SELECT pull_request_id, 'review_submitted', submitted_at, reviewer_id, '', state, FALSE, 3, id
+    FROM reviews
+    UNION ALL
+    SELECT pull_request_id, 'merged', merged_at, '', '', '', force_merged, 4, 0
+    FROM pull_requests WHERE status = 'MERGED' AND merged_at IS NOT NULL
+) history
+ORDER BY pull_request_id, occurred_at, ord, source_id;
+
+-- +goose Down
+DROP TABLE IF EXISTS pull_request_events;
@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_id VARCHAR(64) NOT NULL UNIQUE,
+    event VARCHAR(64) NOT NULL,
+    payload JSONB NOT NULL,
+    occurred_at TIMESTAMPTZ NOT NULL
+);
+
+-- Отметки о передаче события каждому sink. Отметка, а не курсор по id: транзакция с меньшим id
+-- может зафиксироваться позже, и курсор пропустил бы её событие.
+CREATE TABLE IF NOT EXISTS outbox_published (
+    outbox_id BIGINT NOT NULL REFERENCES outbox(id) ON DELETE CASCADE,
+    sink VARCHAR(64) NOT NULL,
+    published_at TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (sink, outbox_id)
+);
+
+-- +goose Down
+DROP TABLE IF EXISTS outbox_published;
+DROP TABLE IF EXISTS outbox;
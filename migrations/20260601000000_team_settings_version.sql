@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS version INTEGER NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE teams DROP COLUMN IF EXISTS version;
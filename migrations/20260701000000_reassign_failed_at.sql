@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE review_assignments ADD COLUMN IF NOT EXISTS reassign_failed_at TIMESTAMP;
+
+-- +goose Down
+ALTER TABLE review_assignments DROP COLUMN IF EXISTS reassign_failed_at;
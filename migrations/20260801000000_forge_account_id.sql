@@ -0,0 +1,8 @@
+-- +goose Up
+-- GitLab передаёт автора MR только числовым id аккаунта
+ALTER TABLE forge_identities ADD COLUMN IF NOT EXISTS account_id BIGINT CHECK (account_id > 0);
+CREATE UNIQUE INDEX IF NOT EXISTS forge_identities_account_uniq ON forge_identities (forge, account_id) WHERE account_id IS NOT NULL;
+
+-- +goose Down
+DROP INDEX IF EXISTS forge_identities_account_uniq;
+ALTER TABLE forge_identities DROP COLUMN IF EXISTS account_id;
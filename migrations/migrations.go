@@ -1,8 +1,10 @@
 package migrations
 
 import (
+	"context"
 	"database/sql"
 	"embed"
+	"fmt"
 
 	"github.com/pressly/goose/v3"
 )
@@ -17,3 +19,32 @@ func RunMigrations(db *sql.DB) error {
 	}
 	return goose.Up(db, ".")
 }
+
+// Checker сверяет версию схемы в БД с последней встроенной миграцией. Провайдер goose не меняет глобальное
+// состояние пакета goose, поэтому параллельные проверки не мешают друг другу и RunMigrations.
+type Checker struct {
+	provider *goose.Provider
+	latest   int64
+}
+
+// NewChecker один раз собирает встроенные миграции и запоминает последнюю версию
+func NewChecker(db *sql.DB) (*Checker, error) {
+	provider, err := goose.NewProvider(goose.DialectPostgres, db, embedMigrations)
+	if err != nil {
+		return nil, err
+	}
+	sources := provider.ListSources()
+	return &Checker{provider: provider, latest: sources[len(sources)-1].Version}, nil
+}
+
+// CheckUpToDate возвращает ошибку, если версия схемы в БД отстаёт от последней встроенной миграции
+func (c *Checker) CheckUpToDate(ctx context.Context) error {
+	current, err := c.provider.GetDBVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if current < c.latest {
+		return fmt.Errorf("database schema version %d is behind latest migration %d", current, c.latest)
+	}
+	return nil
+}